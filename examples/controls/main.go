@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	. "github.com/markschellhas/linnui/ui"
+)
+
+func main() {
+	notifications := NewState(true)
+	newsletter := NewState(false)
+	selectAll := NewState(false)
+	someSelected := NewState(true)
+	wifi := NewState(true)
+	size := NewState("m")
+	volume := NewState[float32](40)
+	minPrice := NewState[float32](100)
+	maxPrice := NewState[float32](400)
+	priority := NewState(2)
+	country := NewState("")
+
+	Run(func() Widget {
+		return Scaffold(
+			AppBar(TitleBar("LinnUI Controls")),
+			Body(ScrollView(Padding(InsetsAll(16), Column([]any{
+				Text("Checkboxes", Style(H6)),
+				Checkbox("Notifications", notifications),
+				Checkbox("Newsletter", newsletter),
+				Checkbox("Select all (tristate)", selectAll, Indeterminate(someSelected)),
+				Checkbox("Disabled", notifications, ToggleDisabled()),
+
+				Text("Switches", Style(H6)),
+				Row([]any{Text("Wi-Fi"), Spacer(), Switch(wifi)}, RowCrossAxis(CrossAxisCenter)),
+				Row([]any{Text("Disabled"), Spacer(), Switch(wifi, ToggleDisabled())}, RowCrossAxis(CrossAxisCenter)),
+
+				Text("Radio buttons", Style(H6)),
+				RadioGroup(size, []RadioItem{Radio("s", "Small"), Radio("m", "Medium"), Radio("l", "Large")}),
+				Text("Selected size: " + size.Get()),
+
+				Text("Sliders", Style(H6)),
+				Slider(volume, SliderMax(100), SliderStep(1), ValueLabel(nil), OnChangeEnd(func(v float32) {
+					ShowSnackbar(fmt.Sprintf("Volume set to %d", int(v)))
+				})),
+				RangeSlider(minPrice, maxPrice, SliderMax(500), SliderStep(10), ValueLabel(nil)),
+
+				Text("Menus", Style(H6)),
+				Dropdown([]int{1, 2, 3}, priority, func(p int) string {
+					return []string{"Low", "Normal", "High"}[p-1] + " priority"
+				}),
+				Autocomplete([]string{"Argentina", "Australia", "Austria", "Belgium", "Brazil", "Canada", "Germany", "Ghana", "New Zealand", "South Africa"},
+					country, Hint("Country")),
+			}, CrossAxis(CrossAxisStretch))))),
+		)
+	}, WindowTitle("LinnUI Controls Example"))
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+
+	. "github.com/markschellhas/linnui/ui"
+)
+
+func main() {
+	status := NewState("Nothing chosen yet")
+
+	Run(func() Widget {
+		return Scaffold(
+			AppBar(TitleBar("LinnUI Dialogs")),
+			Body(Padding(InsetsAll(16), Column([]any{
+				Button("Discard draft", OnClick(func() {
+					ShowDialog(context.Background(),
+						AlertDialog("Discard draft?", Text("Your changes will be lost."),
+							DialogAction("Cancel", false),
+							DialogAction("Discard", true),
+						),
+						OnResult(func(result any) {
+							if result == true {
+								status.Set("Draft discarded")
+							} else {
+								status.Set("Draft kept")
+							}
+						}),
+					)
+				})),
+				Button("Share", Variant(Outlined), OnClick(func() {
+					ShowBottomSheet(context.Background(),
+						Column([]any{
+							Text("Share via", Style(H6)),
+							DialogAction("Email", "email"),
+							DialogAction("Messages", "messages"),
+							DialogAction("Copy link", "link"),
+						}, CrossAxis(CrossAxisStart)),
+						OnResult(func(result any) {
+							if target, ok := result.(string); ok {
+								status.Set("Shared via " + target)
+							}
+						}),
+					)
+				})),
+				Button("Archive", Variant(TextButton), OnClick(func() {
+					status.Set("Message archived")
+					ShowSnackbar("Message archived", Action("Undo", func() {
+						status.Set("Archive undone")
+					}))
+				})),
+				Text(status.Get()),
+			}, Spacing(12), CrossAxis(CrossAxisStart)))),
+		)
+	}, WindowTitle("LinnUI Dialogs Example"))
+}
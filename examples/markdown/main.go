@@ -0,0 +1,25 @@
+package main
+
+import (
+	. "github.com/markschellhas/linnui/ui"
+)
+
+const notes = "### What's new\n\n" +
+	"LinnUI now renders **Markdown**, so help screens can be written as plain text.\n\n" +
+	"- Headings, *emphasis* and `inline code`\n" +
+	"- Ordered and bullet lists\n" +
+	"- Block quotes and code blocks\n\n" +
+	"> Tip: links go to the handler passed with OnLinkTap.\n\n" +
+	"```go\nMarkdown(notes, OnLinkTap(openURL))\n```\n\n" +
+	"Read more on [GitHub](https://github.com/markschellhas/linnui).\n"
+
+func main() {
+	Run(func() Widget {
+		return Scaffold(
+			AppBar(TitleBar("LinnUI Markdown Example")),
+			Body(Markdown(notes, OnLinkTap(func(url string) {
+				ShowSnackbar("Link tapped: " + url)
+			}))),
+		)
+	}, WindowTitle("LinnUI Markdown Example"))
+}
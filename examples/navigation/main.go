@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	. "github.com/markschellhas/linnui/ui"
+)
+
+var users = []string{"Ada", "Grace", "Linus", "Margaret"}
+
+func main() {
+	var nav *Navigator
+	nav = NewNavigator(
+		Route("/", func(Params) Widget {
+			items := []any{Text("Users", Style(H6))}
+			for i, name := range users {
+				items = append(items, Button(name, Variant(TextButton), OnClick(func() {
+					nav.Push("/users/" + strconv.Itoa(i))
+				})))
+			}
+			return Padding(InsetsAll(16), Column(items, CrossAxis(CrossAxisStart)))
+		}),
+		Route("/users/:id", func(p Params) Widget {
+			id := p.Int("id")
+			return Padding(InsetsAll(16), Column([]any{
+				Text(users[id], Style(H4)),
+				Text(fmt.Sprintf("User #%d", id)),
+				Button("Settings", OnClick(func() { nav.Push("/settings") })),
+				Button("Back", Variant(Outlined), OnClick(func() { nav.Pop() })),
+			}, Spacing(12), CrossAxis(CrossAxisStart)))
+		}),
+		Route("/settings", func(Params) Widget {
+			return Center(Text("Settings (press Escape to go back)"))
+		}),
+	)
+
+	// Open a page from the command line (-page /users/2) or, in a browser, from the URL hash
+	flag.TextVar(nav, "page", nav, "page to open")
+	flag.Parse()
+	nav.BindLocationHash()
+
+	Run(func() Widget {
+		return Scaffold(
+			AppBar(TitleBar("LinnUI Navigation")),
+			Body(nav.View()),
+		)
+	}, WindowTitle("LinnUI Navigation Example"))
+}
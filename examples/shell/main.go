@@ -0,0 +1,30 @@
+package main
+
+import (
+	. "github.com/markschellhas/linnui/ui"
+)
+
+func main() {
+	page := NewState(0)
+	destinations := []NavDestination{
+		NavItem("Home", nil),
+		NavItem("Search", nil),
+		NavItem("Library", nil),
+	}
+	pages := []string{"Welcome home", "Search for anything", "Your saved items"}
+
+	Run(func() Widget {
+		return Scaffold(
+			AppBar(TitleBar("LinnUI Shell")),
+			Drawer(NavigationDrawer(page, destinations...)),
+			// Resize the window: the bar becomes a rail from 600dp wide
+			BottomNavigation(page, destinations...),
+			NavigationRail(page, destinations...),
+			Body(Center(Text(pages[page.Get()], Style(H5)))),
+			FAB(ExtendedFAB(nil, "New playlist", OnClick(func() {
+				ShowSnackbar("Playlist created")
+			}))),
+			FABPosition(FABCenterDocked),
+		)
+	}, WindowTitle("LinnUI Shell"), WindowSize(480, 640))
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+
+	. "github.com/markschellhas/linnui/ui"
+)
+
+func main() {
+	selected := NewState(0)
+
+	// Enough tabs that the bar scrolls on a narrow window
+	var tabs []Tab
+	for _, folder := range []string{"Inbox", "Starred", "Sent", "Drafts", "Archive", "Spam", "Trash"} {
+		tabs = append(tabs, TabItem(folder, Center(Text(fmt.Sprintf("%s is empty", folder)))))
+	}
+
+	Run(func() Widget {
+		return Scaffold(
+			AppBar(TitleBar("LinnUI Tabs")),
+			Bottom(TabBar(selected, tabs)),
+			Body(TabPages(selected, tabs, Swipeable())),
+		)
+	}, WindowTitle("LinnUI Tabs"), WindowSize(480, 400))
+}
@@ -7,6 +7,9 @@ toolchain go1.24.11
 require (
 	gioui.org v0.9.0
 	gioui.org/x v0.9.0
+	github.com/yuin/goldmark v1.8.6
+	golang.org/x/image v0.26.0
+	golang.org/x/sys v0.33.0
 )
 
 require (
@@ -14,7 +17,5 @@ require (
 	github.com/go-text/typesetting v0.3.0 // indirect
 	golang.org/x/exp v0.0.0-20250408133849-7e4ce0ab07d0 // indirect
 	golang.org/x/exp/shiny v0.0.0-20250408133849-7e4ce0ab07d0 // indirect
-	golang.org/x/image v0.26.0 // indirect
-	golang.org/x/sys v0.33.0 // indirect
 	golang.org/x/text v0.24.0 // indirect
 )
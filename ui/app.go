@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"gioui.org/app"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// AppOption configures the App
+type AppOption func(*App)
+
+// WindowTitle sets the window title
+func WindowTitle(title string) AppOption {
+	return func(a *App) { a.options = append(a.options, app.Title(title)) }
+}
+
+// WindowSize sets the initial window size in dp
+func WindowSize(width, height float32) AppOption {
+	return func(a *App) { a.options = append(a.options, app.Size(unit.Dp(width), unit.Dp(height))) }
+}
+
+// MinWindowSize sets the minimum window size in dp
+func MinWindowSize(width, height float32) AppOption {
+	return func(a *App) { a.options = append(a.options, app.MinSize(unit.Dp(width), unit.Dp(height))) }
+}
+
+// AppTheme sets a fixed theme used to lay out the root widget in every mode (defaults to Light)
+func AppTheme(th *Theme) AppOption {
+	return func(a *App) { a.themes = NewThemeProvider(LightMode, LightTheme(th), DarkTheme(th)) }
+}
+
+// AppThemeMode sets the initial theme mode of the app's own theme provider
+// It applies after the other options, so it works before or after AppTheme.
+func AppThemeMode(mode ThemeMode) AppOption {
+	return func(a *App) { a.mode = &mode }
+}
+
+// Themes lays out the app with a shared theme provider, so other code can switch the theme at runtime
+// Usage: themes := NewThemeProvider(SystemMode); Run(root, Themes(themes))
+func Themes(p *ThemeProvider) AppOption {
+	return func(a *App) { a.themes = p }
+}
+
+// App owns a Gio window and its event loop
+type App struct {
+	window  *app.Window
+	scope   *Scope
+	root    func() Widget
+	themes  *ThemeProvider
+	focused bool
+	options []app.Option
+	mode    *ThemeMode // set by AppThemeMode
+}
+
+// NewApp creates an app whose root widget is rebuilt by root on every frame
+func NewApp(root func() Widget, opts ...AppOption) *App {
+	a := &App{
+		window: new(app.Window),
+		scope:  NewScope(),
+		root:   root,
+		themes: NewThemeProvider(LightMode),
+	}
+	a.scope.invalidate = a.window.Invalidate
+	for _, opt := range opts {
+		opt(a)
+	}
+	if a.mode != nil {
+		if *a.mode == SystemMode {
+			// Query synchronously, like NewThemeProvider, so the first frame already uses the right theme
+			a.themes.system.Set(systemBrightness())
+		}
+		a.themes.mode.Set(*a.mode)
+	}
+	if len(a.options) > 0 {
+		a.window.Option(a.options...)
+	}
+	return a
+}
+
+// Window returns the underlying Gio window
+func (a *App) Window() *app.Window {
+	return a.window
+}
+
+// Scope returns the scope holding the widget state of this app's window
+func (a *App) Scope() *Scope {
+	return a.scope
+}
+
+// Themes returns the theme provider the app is laid out with
+func (a *App) Themes() *ThemeProvider {
+	return a.themes
+}
+
+// Invalidate requests a redraw of the app window
+func (a *App) Invalidate() {
+	a.window.Invalidate()
+}
+
+// Run processes window events until the window is closed and returns the exit error.
+// Run blocks, so call it from its own goroutine and call app.Main from main.
+func (a *App) Run() error {
+	var ops op.Ops
+	for {
+		switch e := a.window.Event().(type) {
+		case app.DestroyEvent:
+			UnbindWindow(a.window)
+			a.scope.Release()
+			return e.Err
+		case app.ConfigEvent:
+			// The system theme may have changed while the window was in the background;
+			// query it off the event loop, the window redraws when the result arrives
+			if e.Config.Focused && !a.focused && a.themes.Mode() == SystemMode {
+				a.themes.refreshSystemBrightness()
+			}
+			a.focused = e.Config.Focused
+		case app.FrameEvent:
+			gtx := app.NewContext(&ops, e)
+			a.frame(gtx)
+			e.Frame(gtx.Ops)
+		}
+	}
+}
+
+// frame builds and lays out the root widget for a single frame
+func (a *App) frame(gtx layout.Context) {
+	frameMu.Lock()
+	defer frameMu.Unlock()
+
+	// Every State read while building this frame binds itself to the window
+	buildingWindow.Store(a.window)
+	defer buildingWindow.Store(nil)
+
+	// Reading the theme binds its provider, so switching themes redraws the window
+	th := a.themes.Theme()
+
+	// Paint the theme background so dark themes don't sit on the window's white
+	paint.Fill(gtx.Ops, th.Palette.Background)
+
+	if a.root == nil {
+		return
+	}
+	a.scope.layout(gtx, th, a.root())
+}
+
+// frameMu serialises frame building so State reads are attributed to the right window
+var frameMu sync.Mutex
+
+// buildingWindow is the window whose frame is currently being built, if any
+var buildingWindow atomic.Pointer[app.Window]
+
+// Run creates a window for root and runs it until it is closed, then exits the process.
+// Run must be called from the main goroutine and does not return on desktop platforms,
+// so it cannot return the window's exit error: it logs it and exits with status 1 instead.
+// To handle the error yourself, call NewApp(root, opts...).Run from a goroutine and app.Main from main.
+// Usage: ui.Run(func() ui.Widget { return ui.Center(ui.Text("Hi")) }, ui.WindowTitle("Hello"))
+func Run(root func() Widget, opts ...AppOption) {
+	a := NewApp(root, opts...)
+	go func() {
+		if err := a.Run(); err != nil {
+			log.Fatal(err)
+		}
+		os.Exit(0)
+	}()
+	app.Main()
+}
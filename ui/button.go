@@ -3,7 +3,6 @@ package ui
 import (
 	"image"
 	"image/color"
-	"sync"
 
 	"gioui.org/layout"
 	"gioui.org/op/clip"
@@ -13,12 +12,6 @@ import (
 	"gioui.org/widget/material"
 )
 
-// buttonRegistry stores clickable state by button label
-var (
-	buttonRegistry = make(map[string]*widget.Clickable)
-	buttonMu       sync.Mutex
-)
-
 // ButtonVariant defines the visual style of a button
 type ButtonVariant int
 
@@ -43,7 +36,7 @@ func Variant(v ButtonVariant) ButtonOption {
 }
 
 // ButtonID sets a unique ID for the button (for state persistence)
-// Use this when you have multiple buttons with the same label
+// Without an ID the button's state is keyed by its position in the widget tree
 func ButtonID(id string) ButtonOption {
 	return func(b *buttonModel) { b.id = id }
 }
@@ -56,23 +49,14 @@ type buttonModel struct {
 	onClick func()
 }
 
-// getClickable returns a persistent clickable for the given ID
-func getClickable(id string) *widget.Clickable {
-	buttonMu.Lock()
-	defer buttonMu.Unlock()
-
-	if c, ok := buttonRegistry[id]; ok {
-		return c
-	}
-	c := new(widget.Clickable)
-	buttonRegistry[id] = c
-	return c
+// getClickable returns a persistent clickable for the given ID in the current scope
+func getClickable(gtx layout.Context, id string) *widget.Clickable {
+	return scopedState(gtx, "button", id, func() *widget.Clickable { return new(widget.Clickable) })
 }
 
 // Button creates a clickable button widget
 func Button(label string, opts ...ButtonOption) Widget {
 	b := &buttonModel{
-		id:      label, // Default ID is the label
 		label:   label,
 		variant: Filled, // sensible default
 	}
@@ -80,63 +64,69 @@ func Button(label string, opts ...ButtonOption) Widget {
 		opt(b)
 	}
 
-	// Get persistent clickable using the ID
-	clickable := getClickable(b.id)
-	onClick := b.onClick // Capture the handler
-
 	return func(gtx layout.Context, th *Theme) layout.Dimensions {
-		// Handle clicks
-		for clickable.Clicked(gtx) {
-			if onClick != nil {
-				onClick()
-			}
-		}
+		dims := b.layout(gtx, th)
+		describe(gtx, "button", b.id, dims)
+		return dims
+	}
+}
+
+// layout handles clicks and draws the button in its variant's style
+func (b *buttonModel) layout(gtx layout.Context, th *Theme) layout.Dimensions {
+	// Get persistent clickable using the ID
+	clickable := getClickable(gtx, b.id)
 
-		// Base material button with ripple
-		mat := material.Button(th.Theme, clickable, b.label)
-
-		// Apply variant-specific styling
-		switch b.variant {
-		case Filled:
-			mat.Background = th.Palette.Primary
-			mat.Color = th.Palette.OnPrimary
-			mat.CornerRadius = unit.Dp(12)
-		case Outlined:
-			mat.Background = color.NRGBA{A: 0} // Transparent
-			mat.Color = th.Palette.Primary
-			mat.CornerRadius = unit.Dp(12)
-			// Draw button first, then add outline
-			return layout.Stack{}.Layout(gtx,
-				layout.Stacked(func(gtx layout.Context) layout.Dimensions {
-					return mat.Layout(gtx)
-				}),
-				layout.Expanded(func(gtx layout.Context) layout.Dimensions {
-					size := gtx.Constraints.Min
-					radius := gtx.Dp(unit.Dp(12))
-					borderWidth := gtx.Dp(unit.Dp(1))
-
-					// Draw outline using stroke
-					rect := image.Rect(0, 0, size.X, size.Y)
-					outline := clip.Stroke{
-						Path:  clip.UniformRRect(rect, radius).Path(gtx.Ops),
-						Width: float32(borderWidth),
-					}.Op().Push(gtx.Ops)
-					paint.Fill(gtx.Ops, th.Palette.Primary)
-					outline.Pop()
-
-					return layout.Dimensions{Size: size}
-				}),
-			)
-		case TextButton:
-			mat.Background = color.NRGBA{A: 0} // Transparent
-			mat.Color = th.Palette.Primary
-			mat.CornerRadius = unit.Dp(12)
-		case Elevated:
-			mat.Background = th.Palette.SurfaceVariant
-			mat.Color = th.Palette.Primary
-			mat.CornerRadius = unit.Dp(12)
+	// Handle clicks
+	for clickable.Clicked(gtx) {
+		if b.onClick != nil {
+			b.onClick()
 		}
+	}
 
-		return mat.Layout(gtx)
+	// Base material button with ripple
+	mat := material.Button(th.Theme, clickable, b.label)
+
+	// Apply variant-specific styling
+	switch b.variant {
+	case Filled:
+		mat.Background = th.Palette.Primary
+		mat.Color = th.Palette.OnPrimary
+		mat.CornerRadius = unit.Dp(12)
+	case Outlined:
+		mat.Background = color.NRGBA{A: 0} // Transparent
+		mat.Color = th.Palette.Primary
+		mat.CornerRadius = unit.Dp(12)
+		// Draw button first, then add outline
+		return layout.Stack{}.Layout(gtx,
+			layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+				return mat.Layout(gtx)
+			}),
+			layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+				size := gtx.Constraints.Min
+				radius := gtx.Dp(unit.Dp(12))
+				borderWidth := gtx.Dp(unit.Dp(1))
+
+				// Draw outline using stroke
+				rect := image.Rect(0, 0, size.X, size.Y)
+				outline := clip.Stroke{
+					Path:  clip.UniformRRect(rect, radius).Path(gtx.Ops),
+					Width: float32(borderWidth),
+				}.Op().Push(gtx.Ops)
+				paint.Fill(gtx.Ops, th.Palette.Primary)
+				outline.Pop()
+
+				return layout.Dimensions{Size: size}
+			}),
+		)
+	case TextButton:
+		mat.Background = color.NRGBA{A: 0} // Transparent
+		mat.Color = th.Palette.Primary
+		mat.CornerRadius = unit.Dp(12)
+	case Elevated:
+		mat.Background = th.Palette.SurfaceVariant
+		mat.Color = th.Palette.Primary
+		mat.CornerRadius = unit.Dp(12)
 	}
+
+	return mat.Layout(gtx)
 }
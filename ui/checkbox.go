@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"image"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// getBool returns a persistent boolean toggle for the given kind and ID in the current scope
+func getBool(gtx layout.Context, kind, id string) *widget.Bool {
+	return scopedState(gtx, kind, id, func() *widget.Bool { return new(widget.Bool) })
+}
+
+// Checkbox creates a labelled checkbox bound to a boolean state
+// Usage: Checkbox("Remember me", remember, ToggleDisabled())
+func Checkbox(label string, checked *State[bool], opts ...ToggleOption) Widget {
+	t := newToggleModel(opts)
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		b := getBool(gtx, "checkbox", t.id)
+		b.Value = checked.Get()
+		indeterminate := t.indeterminate != nil && t.indeterminate.Get()
+
+		if t.disabled {
+			gtx = gtx.Disabled()
+		}
+		if b.Update(gtx) {
+			switch {
+			case t.indeterminate == nil:
+				checked.Set(b.Value)
+			case indeterminate:
+				t.indeterminate.Set(false)
+				checked.Set(false)
+			case !b.Value: // was checked
+				t.indeterminate.Set(true)
+				checked.Set(false)
+			default:
+				checked.Set(true)
+			}
+			b.Value = checked.Get()
+			indeterminate = t.indeterminate != nil && t.indeterminate.Get()
+		}
+
+		return b.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layoutToggleTarget(gtx, th, b.Hovered() && !t.disabled, func(gtx layout.Context) layout.Dimensions {
+						return drawCheckbox(gtx, th, b.Value, indeterminate, t.disabled)
+					})
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layoutToggleLabel(gtx, th, label, t.disabled)
+				}),
+			)
+		})
+	}
+}
+
+// drawCheckbox paints an 18dp Material 3 checkbox
+func drawCheckbox(gtx layout.Context, th *Theme, checked, indeterminate, disabled bool) layout.Dimensions {
+	size := gtx.Dp(unit.Dp(18))
+	radius := gtx.Dp(unit.Dp(2))
+	stroke := float32(gtx.Dp(unit.Dp(2)))
+	box := image.Rectangle{Max: image.Pt(size, size)}
+
+	if !checked && !indeterminate {
+		c := th.Palette.OnSurfaceVariant
+		if disabled {
+			c = withAlpha(th.Palette.OnSurface, disabledAlpha)
+		}
+		inner := box.Inset(int(stroke / 2))
+		paint.FillShape(gtx.Ops, c, clip.Stroke{Path: clip.UniformRRect(inner, radius).Path(gtx.Ops), Width: stroke}.Op())
+		return layout.Dimensions{Size: box.Max}
+	}
+
+	fill, mark := th.Palette.Primary, th.Palette.OnPrimary
+	if disabled {
+		fill, mark = withAlpha(th.Palette.OnSurface, disabledAlpha), th.Palette.Surface
+	}
+	paint.FillShape(gtx.Ops, fill, clip.UniformRRect(box, radius).Op(gtx.Ops))
+
+	s := float32(size)
+	var p clip.Path
+	p.Begin(gtx.Ops)
+	if indeterminate {
+		p.MoveTo(f32.Pt(s*0.25, s*0.5))
+		p.LineTo(f32.Pt(s*0.75, s*0.5))
+	} else {
+		p.MoveTo(f32.Pt(s*0.22, s*0.52))
+		p.LineTo(f32.Pt(s*0.42, s*0.72))
+		p.LineTo(f32.Pt(s*0.78, s*0.32))
+	}
+	paint.FillShape(gtx.Ops, mark, clip.Stroke{Path: p.End(), Width: stroke}.Op())
+	return layout.Dimensions{Size: box.Max}
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/input"
+	"gioui.org/io/pointer"
+)
+
+func TestCheckboxTristateOrder(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+	var r input.Router
+	var frame int
+
+	checked, indeterminate := NewState(false), NewState(false)
+	w := Checkbox("Select all", checked, Indeterminate(indeterminate))
+	routedFrame(s, &r, w, &frame)
+
+	tap := func() {
+		e := pointer.Event{Source: pointer.Mouse, Buttons: pointer.ButtonPrimary, Position: f32.Pt(20, 20)}
+		e.Kind = pointer.Press
+		r.Queue(e)
+		e.Kind = pointer.Release
+		r.Queue(e)
+		routedFrame(s, &r, w, &frame)
+	}
+	type state struct{ checked, indeterminate bool }
+	want := []state{
+		{true, false}, // unchecked → checked
+		{false, true}, // checked → indeterminate
+		{false, false},
+		{true, false},
+	}
+	for i, next := range want {
+		tap()
+		if got := (state{checked.Get(), indeterminate.Get()}); got != next {
+			t.Fatalf("after tap %d: checked, indeterminate = %v, want %v", i+1, got, next)
+		}
+	}
+}
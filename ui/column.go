@@ -18,47 +18,33 @@ func MainAxis(align MainAxisAlignment) ColumnOption {
 	return func(c *columnModel) { c.mainAlign = align }
 }
 
-// columnModel holds configuration (internal)
-type columnModel struct {
-	spacing   unit.Dp
-	mainAlign MainAxisAlignment
-	children  []any // Can be Widget or FlexWidget
+// CrossAxis sets the cross axis alignment for Column
+// CrossAxisBaseline behaves like CrossAxisStart in a Column
+func CrossAxis(align CrossAxisAlignment) ColumnOption {
+	return func(c *columnModel) { c.crossAlign = align }
 }
 
+// columnModel holds configuration (internal)
+type columnModel = flexModel
+
 // Column creates a vertical layout
 // Children can be Widget or FlexWidget (from Spacer/Expanded)
 func Column(children []any, opts ...ColumnOption) Widget {
 	c := &columnModel{
-		spacing:   unit.Dp(8),
-		mainAlign: MainAxisStart,
-		children:  children,
+		axis:       layout.Vertical,
+		spacing:    unit.Dp(8),
+		mainAlign:  MainAxisStart,
+		crossAlign: CrossAxisStart,
+		children:   children,
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.crossAlign == CrossAxisBaseline {
+		c.crossAlign = CrossAxisStart
+	}
 
 	return func(gtx layout.Context, th *Theme) layout.Dimensions {
-		flexChildren := make([]layout.FlexChild, 0, len(c.children)*2)
-		for i, child := range c.children {
-			if i > 0 && c.spacing > 0 {
-				flexChildren = append(flexChildren, layout.Rigid(layout.Spacer{Height: c.spacing}.Layout))
-			}
-
-			switch w := child.(type) {
-			case FlexWidget:
-				// Flexible child (Spacer or Expanded)
-				widget := w.Widget
-				flexChildren = append(flexChildren, layout.Flexed(w.Flex, func(gtx layout.Context) layout.Dimensions {
-					return widget(gtx, th)
-				}))
-			case Widget:
-				// Regular rigid child
-				widget := w
-				flexChildren = append(flexChildren, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-					return widget(gtx, th)
-				}))
-			}
-		}
-		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, flexChildren...)
+		return c.layout(gtx, th)
 	}
 }
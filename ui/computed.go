@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"gioui.org/app"
+)
+
+// Observable is a reactive value that others can depend on
+// State and ComputedState both implement it
+type Observable interface {
+	// Watch registers fn to be called after every change and returns a func that removes it
+	Watch(fn func()) (unwatch func())
+}
+
+// ComputedState is a read-only reactive value derived from other Observables
+type ComputedState[T comparable] struct {
+	state   *State[T]
+	compute func() T
+	unwatch []func()
+}
+
+// Computed creates a value derived from deps that recomputes only when one of them changes
+// The result is cached, and bound windows and subscribers are notified when it changes
+// Usage: total := Computed(func() int { return a.Get() + b.Get() }, a, b)
+func Computed[T comparable](fn func() T, deps ...Observable) *ComputedState[T] {
+	c := &ComputedState[T]{
+		state:   NewState(fn()),
+		compute: fn,
+	}
+	for _, dep := range deps {
+		c.unwatch = append(c.unwatch, dep.Watch(c.recompute))
+	}
+	return c
+}
+
+// recompute refreshes the cached value after a dependency changed
+func (c *ComputedState[T]) recompute() {
+	c.state.Set(c.compute())
+}
+
+// Get the cached value (safe for reading)
+func (c *ComputedState[T]) Get() T {
+	return c.state.Get()
+}
+
+// Bind sets up the computed value for reactivity in this app window
+func (c *ComputedState[T]) Bind(w *app.Window) *ComputedState[T] {
+	c.state.Bind(w)
+	return c
+}
+
+// Unbind stops redrawing the window when the computed value changes
+func (c *ComputedState[T]) Unbind(w *app.Window) {
+	c.state.Unbind(w)
+}
+
+// Subscribe registers fn to be called with the old and new value after every change
+func (c *ComputedState[T]) Subscribe(fn func(old, new T)) (unsubscribe func()) {
+	return c.state.Subscribe(fn)
+}
+
+// Watch registers fn to be called after every change (implements Observable)
+func (c *ComputedState[T]) Watch(fn func()) (unwatch func()) {
+	return c.state.Watch(fn)
+}
+
+// Dispose stops tracking the dependencies; the cached value is kept
+func (c *ComputedState[T]) Dispose() {
+	for _, unwatch := range c.unwatch {
+		unwatch()
+	}
+	c.unwatch = nil
+}
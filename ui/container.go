@@ -96,26 +96,12 @@ func Container(opts ...any) Widget {
 				// Draw border
 				if m.hasBorder && m.border.Width > 0 {
 					borderWidth := gtx.Dp(unit.Dp(m.border.Width))
-					rect := image.Rect(0, 0, size.X, size.Y)
-
-					// Outer clip
-					outerClip := clip.UniformRRect(rect, radius).Push(gtx.Ops)
-					paint.Fill(gtx.Ops, m.border.Color)
-					outerClip.Pop()
-
-					// Inner clip (punch out the inside)
-					innerRect := image.Rect(borderWidth, borderWidth, size.X-borderWidth, size.Y-borderWidth)
-					innerRadius := radius - borderWidth
-					if innerRadius < 0 {
-						innerRadius = 0
-					}
-					innerClip := clip.UniformRRect(innerRect, innerRadius).Push(gtx.Ops)
-					if m.hasBackground {
-						paint.Fill(gtx.Ops, m.background)
-					} else {
-						paint.Fill(gtx.Ops, color.NRGBA{A: 0})
-					}
-					innerClip.Pop()
+					// Stroke along the middle of the border so it stays inside the box
+					// and leaves the background (or whatever is behind) showing through
+					inset := borderWidth / 2
+					rect := image.Rect(inset, inset, size.X-inset, size.Y-inset)
+					path := clip.UniformRRect(rect, max(radius-inset, 0)).Path(gtx.Ops)
+					paint.FillShape(gtx.Ops, m.border.Color, clip.Stroke{Path: path, Width: float32(borderWidth)}.Op())
 				}
 
 				return layout.Dimensions{Size: size}
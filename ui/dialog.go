@@ -0,0 +1,398 @@
+package ui
+
+import (
+	"context"
+	"image"
+	"slices"
+	"time"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// DialogOption configures a dialog or bottom sheet
+type DialogOption func(*overlay)
+
+// OnResult sets a callback receiving the result the dialog or sheet closed with
+// The callback runs on the goroutine that closed it, usually while a frame is built
+func OnResult(fn func(result any)) DialogOption {
+	return func(o *overlay) { o.onResult = fn }
+}
+
+// NotDismissible keeps the dialog or sheet open on taps outside it and Escape
+// It then only closes through CloseDialog or its context
+func NotDismissible() DialogOption {
+	return func(o *overlay) { o.dismissible = false }
+}
+
+// overlayKind selects how an overlay is placed in the window (internal)
+type overlayKind int
+
+const (
+	dialogOverlay overlayKind = iota
+	sheetOverlay
+)
+
+// overlayEnter is how long dialogs and sheets take to animate in
+const overlayEnter = 200 * time.Millisecond
+
+// overlay is a dialog or bottom sheet on a scope's overlay stack (internal)
+type overlay struct {
+	popup
+	id          int
+	kind        overlayKind
+	content     Widget
+	dismissible bool
+	onResult    func(result any)
+	result      chan any
+	stop        func() bool // stops watching the context
+	shown       time.Time   // first frame the overlay was laid out in
+	closed      bool
+	refocus     event.Tag // widget that had the keyboard focus when the overlay opened
+	first       event.Tag // first clickable or editor laid out in the overlay, focused when it opens
+}
+
+// ShowDialog opens content in a dialog centered above the window, behind a dimmed barrier
+// that blocks input to the widgets underneath. The dialog's first button or text field takes
+// the keyboard focus, which goes back to where it was when the dialog closes. Tapping the barrier or pressing Escape closes the dialog
+// with a nil result, as does cancelling ctx.
+// The returned channel receives the result once the dialog closes; see CloseDialog.
+// Call it from an event handler, or from any goroutine once the window has been laid out.
+// It opens in the window being laid out, else in the last one laid out; with several
+// windows, call the method of the window's Scope instead.
+// Usage: ShowDialog(ctx, AlertDialog("Discard draft?", nil, DialogAction("Cancel", false), DialogAction("Discard", true)))
+func ShowDialog(ctx context.Context, content Widget, opts ...DialogOption) <-chan any {
+	return targetScope().showOverlay(ctx, dialogOverlay, content, opts)
+}
+
+// ShowBottomSheet opens content in a sheet sliding up from the bottom of the window,
+// behind a dimmed barrier. It closes like a dialog and picks its window the same way.
+// Usage: ShowBottomSheet(ctx, Column(shareTargets), OnResult(share))
+func ShowBottomSheet(ctx context.Context, content Widget, opts ...DialogOption) <-chan any {
+	return targetScope().showOverlay(ctx, sheetOverlay, content, opts)
+}
+
+// ShowDialog opens content in a dialog above this scope's window, like the ShowDialog function
+// Usage: app.Scope().ShowDialog(ctx, AlertDialog("Sync failed", nil, DialogAction("OK", nil)))
+func (s *Scope) ShowDialog(ctx context.Context, content Widget, opts ...DialogOption) <-chan any {
+	return s.showOverlay(ctx, dialogOverlay, content, opts)
+}
+
+// ShowBottomSheet opens content in a sheet above this scope's window, like the ShowBottomSheet function
+func (s *Scope) ShowBottomSheet(ctx context.Context, content Widget, opts ...DialogOption) <-chan any {
+	return s.showOverlay(ctx, sheetOverlay, content, opts)
+}
+
+// CloseDialog closes the dialog or bottom sheet whose widgets are handling the current event,
+// or else the topmost one, delivering result to its channel and OnResult callback
+// Usage: Button("Save", OnClick(func() { CloseDialog(form.Get()) }))
+func CloseDialog(result any) {
+	targetScope().CloseDialog(result)
+}
+
+// CloseDialog closes the dialog or bottom sheet of this scope's window, like the CloseDialog function
+func (s *Scope) CloseDialog(result any) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	o := s.current
+	if o == nil && len(s.overlays) > 0 {
+		o = s.overlays[len(s.overlays)-1]
+	}
+	s.mu.Unlock()
+
+	if o != nil {
+		s.closeOverlay(o, result)
+	}
+}
+
+// showOverlay pushes a new overlay onto the stack of s
+func (s *Scope) showOverlay(ctx context.Context, kind overlayKind, content Widget, opts []DialogOption) <-chan any {
+	o := &overlay{kind: kind, content: content, dismissible: true, result: make(chan any, 1)}
+	o.open = true
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if s == nil {
+		// No window to show the overlay in
+		o.result <- nil
+		close(o.result)
+		return o.result
+	}
+
+	s.mu.Lock()
+	o.id = s.nextOverlay
+	s.nextOverlay++
+	s.overlays = append(s.overlays, o)
+	s.mu.Unlock()
+
+	stop := context.AfterFunc(ctx, func() { s.closeOverlay(o, nil) })
+	s.mu.Lock()
+	o.stop = stop
+	s.mu.Unlock()
+	if s.invalidate != nil {
+		s.invalidate()
+	}
+	return o.result
+}
+
+// closeOverlay removes o from the stack and delivers its result; later calls do nothing
+func (s *Scope) closeOverlay(o *overlay, result any) {
+	s.mu.Lock()
+	if o.closed {
+		s.mu.Unlock()
+		return
+	}
+	o.closed = true
+	s.overlays = slices.DeleteFunc(s.overlays, func(other *overlay) bool { return other == o })
+	if o.refocus != nil {
+		s.refocus = o.refocus
+	}
+	stop := o.stop
+	s.mu.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+	if o.onResult != nil {
+		o.onResult(result)
+	}
+	o.result <- result
+	close(o.result)
+	if s.invalidate != nil {
+		s.invalidate()
+	}
+}
+
+// layoutOverlays lays out the overlay stack above the widget tree, bottom first
+func (s *Scope) layoutOverlays(gtx layout.Context, th *Theme) {
+	s.mu.Lock()
+	overlays := slices.Clone(s.overlays)
+	refocus := s.refocus
+	s.refocus = nil
+	s.mu.Unlock()
+
+	// Hand the focus back to the widget that had it before the last closed overlay opened
+	if refocus != nil {
+		gtx.Execute(key.FocusCmd{Tag: refocus})
+	}
+
+	for i, o := range overlays {
+		top := i == len(overlays)-1
+		s.child("overlay", o.id, func() layout.Dimensions {
+			s.mu.Lock()
+			s.current = o
+			s.mu.Unlock()
+			defer func() {
+				s.mu.Lock()
+				s.current = nil
+				s.mu.Unlock()
+			}()
+
+			o.layout(gtx, th, s, top)
+			return layout.Dimensions{}
+		})
+	}
+}
+
+// focused returns the clickable or editor in s that has the keyboard focus, if any
+func (s *Scope) focused(gtx layout.Context) event.Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range s.entries {
+		switch v := e.value.(type) {
+		case *widget.Clickable:
+			if gtx.Focused(v) {
+				return v
+			}
+		case *widget.Editor:
+			if gtx.Focused(v) {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// overlayClosed reports whether o has been closed
+func (s *Scope) overlayClosed(o *overlay) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return o.closed
+}
+
+// layout draws the overlay's barrier and content; only the top overlay listens for dismissal
+func (o *overlay) layout(gtx layout.Context, th *Theme, s *Scope, top bool) {
+	if top && o.dismissible {
+		o.update(gtx)
+		if !o.open {
+			s.closeOverlay(o, nil)
+			return
+		}
+	}
+
+	opening := o.shown.IsZero()
+	if opening {
+		o.shown = gtx.Now
+		o.refocus = s.focused(gtx)
+	}
+	progress := float32(1)
+	if elapsed := gtx.Now.Sub(o.shown); elapsed < overlayEnter {
+		progress = float32(elapsed) / float32(overlayEnter)
+		gtx.Execute(op.InvalidateCmd{})
+	}
+	progress = easeOut(progress)
+
+	window := gtx.Constraints.Max
+	cgtx := gtx
+	macro := op.Record(gtx.Ops)
+	var dims layout.Dimensions
+	var offset image.Point
+	switch o.kind {
+	case dialogOverlay:
+		margin := gtx.Dp(unit.Dp(24))
+		cgtx.Constraints = layout.Constraints{
+			Min: image.Pt(min(gtx.Dp(unit.Dp(280)), window.X-2*margin), 0),
+			Max: image.Pt(min(gtx.Dp(unit.Dp(560)), window.X-2*margin), window.Y-2*margin),
+		}
+		dims = layoutDialogSurface(cgtx, th, o.content)
+		offset = window.Sub(dims.Size).Div(2)
+	case sheetOverlay:
+		width := min(gtx.Dp(unit.Dp(640)), window.X)
+		cgtx.Constraints = layout.Constraints{
+			Min: image.Pt(width, 0),
+			Max: image.Pt(width, window.Y-gtx.Dp(unit.Dp(56))),
+		}
+		dims = layoutSheetSurface(cgtx, th, o.content)
+		offset = image.Pt((window.X-width)/2, window.Y-dims.Size.Y+int(float32(dims.Size.Y)*(1-progress)))
+	}
+	call := macro.Stop()
+	if s.overlayClosed(o) {
+		// Closed by its own widgets while being laid out
+		return
+	}
+	if opening {
+		// Take the focus, so a focused field underneath stops receiving key presses
+		gtx.Execute(key.FocusCmd{Tag: o.first})
+	}
+
+	o.scrim = withAlpha(th.Palette.Scrim, uint8(0x52*progress))
+	o.popup.layout(gtx, th, offset, false, func(gtx layout.Context, th *Theme) layout.Dimensions {
+		if o.kind == dialogOverlay && progress < 1 {
+			defer paint.PushOpacity(gtx.Ops, progress).Pop()
+		}
+		call.Add(gtx.Ops)
+		return dims
+	})
+}
+
+// layoutDialogSurface draws dialog content on a rounded, padded surface
+func layoutDialogSurface(gtx layout.Context, th *Theme, content Widget) layout.Dimensions {
+	return layoutSurface(gtx, th, th.Palette.SurfaceContainerHigh, unit.Dp(28), unit.Dp(3), func(gtx layout.Context) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(24)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return content(gtx, th)
+		})
+	})
+}
+
+// layoutSheetSurface draws bottom sheet content below a drag handle, on a surface with rounded top corners
+func layoutSheetSurface(gtx layout.Context, th *Theme, content Widget) layout.Dimensions {
+	macro := op.Record(gtx.Ops)
+	dims := layout.Flex{Axis: layout.Vertical, Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Top: unit.Dp(22), Bottom: unit.Dp(22)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				size := image.Pt(gtx.Dp(unit.Dp(32)), gtx.Dp(unit.Dp(4)))
+				handle := withAlpha(th.Palette.OnSurfaceVariant, 0x66)
+				paint.FillShape(gtx.Ops, handle, clip.UniformRRect(image.Rectangle{Max: size}, size.Y/2).Op(gtx.Ops))
+				return layout.Dimensions{Size: size}
+			})
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Left: unit.Dp(24), Right: unit.Dp(24), Bottom: unit.Dp(24)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return content(gtx, th)
+			})
+		}),
+	)
+	call := macro.Stop()
+
+	r := gtx.Dp(unit.Dp(28))
+	shape := clip.RRect{Rect: image.Rectangle{Max: dims.Size}, NW: r, NE: r}
+	paint.FillShape(gtx.Ops, th.Palette.SurfaceContainerLow, shape.Op(gtx.Ops))
+	call.Add(gtx.Ops)
+	return dims
+}
+
+// AlertDialog lays out a title, content and a row of actions, for use with ShowDialog
+// content may be nil; actions are usually DialogActions.
+// Usage: AlertDialog("Delete file?", Text("This can't be undone."), DialogAction("Cancel", false), DialogAction("Delete", true))
+func AlertDialog(title string, content Widget, actions ...Widget) Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		pos := scopedContainer(gtx, "alertdialog")
+		gtx.Constraints.Min.Y = 0
+
+		// Lay out title and content first, so the actions can align to their width
+		body := layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if title == "" {
+					return layout.Dimensions{}
+				}
+				l, _ := (&textModel{content: title, style: H5}).label(th)
+				return layout.Inset{Bottom: unit.Dp(16)}.Layout(gtx, l.Layout)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if content == nil {
+					return layout.Dimensions{}
+				}
+				return scopedChild(gtx, pos, 0, func() layout.Dimensions {
+					return content(gtx, th)
+				})
+			}),
+		)
+		if len(actions) == 0 {
+			return body
+		}
+
+		top := body.Size.Y + gtx.Dp(unit.Dp(24))
+		gtx.Constraints.Min.X = max(gtx.Constraints.Min.X, body.Size.X)
+		gtx.Constraints.Max.Y = max(0, gtx.Constraints.Max.Y-top)
+		off := op.Offset(image.Pt(0, top)).Push(gtx.Ops)
+		row := layoutDialogActions(gtx, th, pos, actions)
+		off.Pop()
+		return layout.Dimensions{Size: image.Pt(max(body.Size.X, row.Size.X), top+row.Size.Y)}
+	}
+}
+
+// layoutDialogActions lays out the actions of an AlertDialog at the end of a row
+func layoutDialogActions(gtx layout.Context, th *Theme, pos string, actions []Widget) layout.Dimensions {
+	children := make([]layout.FlexChild, 0, len(actions))
+	for i, action := range actions {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			inset := layout.Inset{}
+			if i > 0 {
+				inset.Left = unit.Dp(8)
+			}
+			return inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return scopedChild(gtx, pos, i+1, func() layout.Dimensions {
+					return action(gtx, th)
+				})
+			})
+		}))
+	}
+	return layout.Flex{Spacing: layout.SpaceStart, Alignment: layout.Middle}.Layout(gtx, children...)
+}
+
+// DialogAction creates a text button that closes the enclosing dialog or sheet with result
+// Usage: DialogAction("Delete", true)
+func DialogAction(label string, result any) Widget {
+	return Button(label, Variant(TextButton), OnClick(func() { CloseDialog(result) }))
+}
@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"context"
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// routedFrame lays out one frame of w in s with input from r, 16ms after the previous one
+func routedFrame(s *Scope, r *input.Router, w Widget, frame *int) {
+	th := Light
+	var ops op.Ops
+	*frame++
+	gtx := layout.Context{
+		Ops:         &ops,
+		Now:         time.Unix(0, 0).Add(time.Duration(*frame) * 16 * time.Millisecond),
+		Constraints: layout.Exact(image.Pt(400, 300)),
+		Source:      r.Source(),
+	}
+	s.Layout(gtx, &th, w)
+	r.Frame(&ops)
+}
+
+func TestDialogTakesAndRestoresFocus(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+	var r input.Router
+	var frame int
+
+	focus := true
+	field := TextField(TextFieldID("name"))
+	w := Widget(func(gtx layout.Context, th *Theme) layout.Dimensions {
+		dims := field(gtx, th)
+		if focus {
+			ed, _ := s.LookupTextField("name")
+			gtx.Execute(key.FocusCmd{Tag: ed})
+			focus = false
+		}
+		return dims
+	})
+	value := func() string {
+		ed, _ := s.LookupTextField("name")
+		return ed.Text()
+	}
+	typeText := func(text string) {
+		end := len([]rune(value()))
+		r.Queue(key.EditEvent{Range: key.Range{Start: end, End: end}, Text: text})
+		routedFrame(s, &r, w, &frame)
+	}
+
+	routedFrame(s, &r, w, &frame)
+	routedFrame(s, &r, w, &frame)
+	typeText("a")
+	if got := value(); got != "a" {
+		t.Fatalf("text before the dialog = %q, want %q", got, "a")
+	}
+
+	s.ShowDialog(context.Background(), Text("Hello"))
+	routedFrame(s, &r, w, &frame)
+	typeText("b")
+	if got := value(); got != "a" {
+		t.Errorf("field under an open dialog received keys: text = %q, want %q", got, "a")
+	}
+
+	s.CloseDialog(nil)
+	routedFrame(s, &r, w, &frame)
+	typeText("c")
+	if got := value(); got != "ac" {
+		t.Errorf("text after the dialog closed = %q, want %q", got, "ac")
+	}
+}
+
+func TestDialogFocusesFirstFocusableWidget(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+	var r input.Router
+	var frame int
+
+	w := SizedBox()
+	routedFrame(s, &r, w, &frame)
+
+	s.ShowDialog(context.Background(), Column([]any{
+		Text("Rename"),
+		TextField(TextFieldID("new_name")),
+		Button("OK", ButtonID("ok")),
+	}))
+	routedFrame(s, &r, w, &frame)
+	r.Queue(key.EditEvent{Text: "notes.txt"})
+	routedFrame(s, &r, w, &frame)
+	if ed, _ := s.LookupTextField("new_name"); ed.Text() != "notes.txt" {
+		t.Errorf("text field in the dialog = %q, want %q", ed.Text(), "notes.txt")
+	}
+	s.CloseDialog(nil)
+	routedFrame(s, &r, w, &frame)
+
+	s.ShowDialog(context.Background(), AlertDialog("Delete?", Text("This can't be undone."),
+		Button("Cancel", ButtonID("cancel")),
+		Button("Delete", ButtonID("delete")),
+	))
+	routedFrame(s, &r, w, &frame)
+	routedFrame(s, &r, w, &frame)
+	cancel, _ := s.LookupButton(ButtonID("cancel"))
+	if !r.Source().Focused(cancel) {
+		t.Error("the first action of an AlertDialog without fields is not focused")
+	}
+}
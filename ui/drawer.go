@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// drawerSlide is how long the drawer takes to slide in or out
+const drawerSlide = 250 * time.Millisecond
+
+// drawerState is the persistent state of a Scaffold's drawer (internal)
+type drawerState struct {
+	popup
+	shown    bool      // the drawer is open or opening
+	changed  time.Time // start of the last slide; zero until the next frame picks it up
+	from     float32   // how far the drawer was open when the slide started
+	progress float32   // how far the drawer is open, from 0 to 1
+}
+
+// setOpen starts sliding the drawer in or out from wherever it is now (caller holds the scope's mu)
+func (d *drawerState) setOpen(open bool, now time.Time) {
+	if open == d.shown {
+		return
+	}
+	d.shown, d.from, d.changed = open, d.progress, now
+	d.popup.open = true
+}
+
+// OpenDrawer slides in the Drawer of the Scaffold being laid out, or else of the window's Scaffold
+// The hamburger button TitleBar shows next to the title does the same.
+func OpenDrawer() {
+	setDrawer(nil, true)
+}
+
+// CloseDrawer slides the drawer opened by OpenDrawer or the hamburger button back out
+func CloseDrawer() {
+	setDrawer(nil, false)
+}
+
+// setDrawer opens or closes the drawer of the Scaffold being laid out in s,
+// or of the active or last laid out scope if s is nil
+func setDrawer(s *Scope, open bool) {
+	if s == nil {
+		s = targetScope()
+	}
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	d := s.drawer
+	if d == nil && s.windowDrawerSet >= s.frame-1 {
+		// Between frames, or outside any Scaffold: the drawer laid out in the last frame, if any
+		d = s.windowDrawer
+	}
+	if d != nil {
+		d.setOpen(open, time.Time{})
+	}
+	s.mu.Unlock()
+	if d != nil && s.invalidate != nil {
+		s.invalidate()
+	}
+}
+
+// layout slides the drawer in from the start edge over a scrim, above the rest of the window
+// Call it before the Scaffold's body, so Escape closes the drawer before a Navigator sees it.
+func (d *drawerState) layout(gtx layout.Context, th *Theme, s *Scope, content Widget) {
+	s.mu.Lock()
+	if d.changed.IsZero() {
+		d.changed = gtx.Now
+	}
+	shown := d.shown
+	s.mu.Unlock()
+
+	if shown {
+		d.popup.update(gtx)
+		if !d.popup.open {
+			// Tapped outside or pressed Escape
+			s.mu.Lock()
+			d.setOpen(false, gtx.Now)
+			s.mu.Unlock()
+		}
+	}
+
+	s.mu.Lock()
+	target := float32(0)
+	if d.shown {
+		target = 1
+	}
+	t := float32(1)
+	if elapsed := gtx.Now.Sub(d.changed); elapsed < drawerSlide {
+		t = float32(elapsed) / float32(drawerSlide)
+		gtx.Execute(op.InvalidateCmd{})
+	}
+	d.progress = d.from + (target-d.from)*easeOut(t)
+	if !d.shown && t >= 1 {
+		d.popup.open = false
+	}
+	progress := d.progress
+	s.mu.Unlock()
+
+	width := min(gtx.Dp(unit.Dp(360)), gtx.Constraints.Max.X-gtx.Dp(unit.Dp(56)))
+	d.popup.scrim = withAlpha(th.Palette.Scrim, uint8(0x52*progress))
+	cgtx := gtx
+	cgtx.Constraints = layout.Exact(image.Pt(width, gtx.Constraints.Max.Y))
+	x := -int((1 - progress) * float32(width))
+	d.popup.layout(cgtx, th, image.Pt(x, 0), false, func(gtx layout.Context, th *Theme) layout.Dimensions {
+		size := gtx.Constraints.Min
+		r := gtx.Dp(unit.Dp(16))
+		shape := clip.RRect{Rect: image.Rectangle{Max: size}, NE: r, SE: r}
+		paint.FillShape(gtx.Ops, th.Palette.SurfaceContainerLow, shape.Op(gtx.Ops))
+		defer shape.Push(gtx.Ops).Pop()
+		if content != nil {
+			content(gtx, th)
+		}
+		return layout.Dimensions{Size: size}
+	})
+}
+
+// NavigationDrawer creates a list of destinations for a Scaffold's Drawer
+// Choosing a destination selects it and closes the drawer.
+// Usage: Drawer(NavigationDrawer(page, NavItem("Inbox", nil), NavItem("Outbox", nil)))
+func NavigationDrawer(selected *State[int], items ...NavDestination) Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "navdrawer", "", newNavState)
+		if st.update(gtx, selected, len(items)) >= 0 {
+			setDrawer(currentScope(gtx), false)
+		}
+		sel := selected.Get()
+
+		gtx.Constraints.Min = image.Point{}
+		return layout.UniformInset(unit.Dp(12)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			children := make([]layout.FlexChild, len(items))
+			for i, item := range items {
+				children[i] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					gtx.Constraints = layout.Exact(image.Pt(gtx.Constraints.Max.X, gtx.Dp(unit.Dp(56))))
+					return layoutDrawerItem(gtx, th, &st.clicks[i], item, i == sel)
+				})
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		})
+	}
+}
+
+// layoutDrawerItem draws a drawer destination filling gtx.Constraints.Min,
+// on a rounded indicator if it is selected
+func layoutDrawerItem(gtx layout.Context, th *Theme, click *widget.Clickable, item NavDestination, selected bool) layout.Dimensions {
+	return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		size := gtx.Constraints.Min
+		rect := image.Rectangle{Max: size}
+		r := size.Y / 2
+		switch {
+		case selected:
+			paint.FillShape(gtx.Ops, th.Palette.SecondaryContainer, clip.UniformRRect(rect, r).Op(gtx.Ops))
+		case click.Hovered() || gtx.Focused(click):
+			paint.FillShape(gtx.Ops, withAlpha(th.Palette.OnSurface, 0x14), clip.UniformRRect(rect, r).Op(gtx.Ops))
+		}
+
+		x := gtx.Dp(unit.Dp(16))
+		if item.icon != nil {
+			icon := gtx.Dp(unit.Dp(24))
+			layoutIcon(gtx, th, item.icon, image.Rect(x, (size.Y-icon)/2, x+icon, (size.Y+icon)/2))
+			x += icon + gtx.Dp(unit.Dp(12))
+		}
+
+		medium := font.Medium
+		l, _ := (&textModel{content: item.label, style: BodyText, weight: &medium, maxLines: 1}).label(th)
+		l.Color = th.Palette.OnSurfaceVariant
+		if selected {
+			l.Color = th.Palette.OnSecondaryContainer
+		}
+		lgtx := gtx
+		lgtx.Constraints = layout.Constraints{Max: image.Pt(max(size.X-x-gtx.Dp(unit.Dp(24)), 0), size.Y)}
+		macro := op.Record(gtx.Ops)
+		labelSize := l.Layout(lgtx).Size
+		label := macro.Stop()
+		defer op.Offset(image.Pt(x, (size.Y-labelSize.Y)/2)).Push(gtx.Ops).Pop()
+		label.Add(gtx.Ops)
+		return layout.Dimensions{Size: size}
+	})
+}
+
+// layoutDrawerButton draws the hamburger button TitleBar shows when its Scaffold has a Drawer
+func layoutDrawerButton(gtx layout.Context, th *Theme, click *widget.Clickable) layout.Dimensions {
+	gtx.Constraints = layout.Exact(image.Pt(gtx.Dp(unit.Dp(48)), gtx.Dp(unit.Dp(48))))
+	return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		size := gtx.Constraints.Min
+		if click.Hovered() || gtx.Focused(click) {
+			d := gtx.Dp(unit.Dp(40))
+			circle := image.Rectangle{Max: image.Pt(d, d)}.Add(size.Sub(image.Pt(d, d)).Div(2))
+			paint.FillShape(gtx.Ops, withAlpha(th.Palette.OnSurface, 0x14), clip.Ellipse(circle).Op(gtx.Ops))
+		}
+
+		// Three bars, 18dp wide and 6dp apart
+		w, h, gap := gtx.Dp(unit.Dp(18)), gtx.Dp(unit.Dp(2)), gtx.Dp(unit.Dp(6))
+		x, y := (size.X-w)/2, (size.Y-h)/2-gap
+		for i := range 3 {
+			bar := image.Rect(x, y+i*gap, x+w, y+i*gap+h)
+			paint.FillShape(gtx.Ops, th.Palette.OnSurfaceVariant, clip.Rect(bar).Op())
+		}
+		return layout.Dimensions{Size: size}
+	})
+}
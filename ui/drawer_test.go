@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"testing"
+
+	"gioui.org/layout"
+)
+
+func TestScaffoldDrawerScoping(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+
+	var inner, after *drawerState
+	probe := func(dst **drawerState) Widget {
+		return func(gtx layout.Context, th *Theme) layout.Dimensions {
+			s.mu.Lock()
+			*dst = s.drawer
+			s.mu.Unlock()
+			return layout.Dimensions{}
+		}
+	}
+	w := Scaffold(
+		Drawer(SizedBox()),
+		Body(Column([]any{Scaffold(Body(probe(&inner))), probe(&after)})),
+	)
+
+	layoutFrames(s, w, 1)
+	if inner != nil {
+		t.Error("nested Scaffold without a drawer sees the outer drawer")
+	}
+	if after == nil {
+		t.Fatal("outer drawer was not restored after the nested Scaffold")
+	}
+
+	setDrawer(s, true)
+	if !after.shown {
+		t.Error("OpenDrawer between frames did not open the window's drawer")
+	}
+	setDrawer(s, false)
+
+	layoutFrames(s, SizedBox(), 2)
+	setDrawer(s, true)
+	if after.shown {
+		t.Error("OpenDrawer opened the drawer of a Scaffold no longer laid out")
+	}
+}
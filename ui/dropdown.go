@@ -0,0 +1,359 @@
+package ui
+
+import (
+	"fmt"
+	"image"
+	"slices"
+	"strings"
+
+	"gioui.org/f32"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+// newFieldModel applies TextField options to the configuration of a Dropdown or Autocomplete
+// Hint sets the text shown while nothing is selected or typed, TextFieldID the ID the field's
+// state is kept under and OnChange a handler for the new text; MultiLine has no effect.
+func newFieldModel(opts []TextFieldOption) *textFieldModel {
+	d := &textFieldModel{}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// menuMaxHeight caps the height of an open menu; longer menus scroll
+const menuMaxHeight = unit.Dp(280)
+
+// menu is the persistent state of a list of choices shown in a popup (internal)
+type menu struct {
+	popup
+	items []widget.Clickable
+	list  widget.List
+	focus int // item to focus once laid out, or -1
+}
+
+// newMenu creates a closed menu
+func newMenu() *menu {
+	return &menu{list: widget.List{List: layout.List{Axis: layout.Vertical}}, focus: -1}
+}
+
+// show opens the menu scrolled to item selected, focusing it when focus is set
+func (m *menu) show(selected int, focus bool) {
+	m.open = true
+	m.list.Position = layout.Position{First: max(selected, 0)}
+	m.focus = -1
+	if focus {
+		m.focus = max(selected, 0)
+	}
+}
+
+// clicked returns the index of the first of n items tapped since the last frame, or -1
+func (m *menu) clicked(gtx layout.Context, n int) int {
+	if !m.open {
+		return -1
+	}
+	m.grow(n)
+	chosen := -1
+	for i := range m.items[:n] {
+		if m.items[i].Clicked(gtx) && chosen == -1 {
+			chosen = i
+		}
+	}
+	return chosen
+}
+
+// grow makes room for n item clickables
+func (m *menu) grow(n int) {
+	if n > len(m.items) {
+		m.items = slices.Grow(m.items, n-len(m.items))[:n]
+	}
+}
+
+// layout draws the open menu below a field of the given size, highlighting item selected
+func (m *menu) layout(gtx layout.Context, th *Theme, field image.Point, labels []string, selected int, pass bool) {
+	if !m.open || len(labels) == 0 {
+		return
+	}
+	m.grow(len(labels))
+
+	gtx.Constraints = layout.Constraints{
+		Min: image.Pt(field.X, 0),
+		Max: image.Pt(field.X, gtx.Dp(menuMaxHeight)),
+	}
+	m.popup.layout(gtx, th, image.Pt(0, field.Y), pass, func(gtx layout.Context, th *Theme) layout.Dimensions {
+		return layoutSurface(gtx, th, th.Palette.SurfaceContainer, unit.Dp(4), unit.Dp(3), func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Top: unit.Dp(8), Bottom: unit.Dp(8)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				list := material.List(th.Theme, &m.list)
+				list.AnchorStrategy = material.Overlay
+				return list.Layout(gtx, len(labels), func(gtx layout.Context, i int) layout.Dimensions {
+					return m.row(gtx, th, i, labels[i], i == selected)
+				})
+			})
+		})
+	})
+}
+
+// row lays out one menu item
+func (m *menu) row(gtx layout.Context, th *Theme, i int, label string, selected bool) layout.Dimensions {
+	item := &m.items[i]
+	dims := item.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min.Y = gtx.Dp(unit.Dp(48))
+		size := image.Pt(gtx.Constraints.Min.X, gtx.Constraints.Min.Y)
+		switch {
+		case selected:
+			paint.FillShape(gtx.Ops, th.Palette.SecondaryContainer, clip.Rect{Max: size}.Op())
+		case gtx.Focused(item):
+			paint.FillShape(gtx.Ops, withAlpha(th.Palette.OnSurface, 0x1F), clip.Rect{Max: size}.Op())
+		case item.Hovered():
+			paint.FillShape(gtx.Ops, withAlpha(th.Palette.OnSurface, 0x14), clip.Rect{Max: size}.Op())
+		}
+		return layout.W.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.Y = 0
+			return layout.Inset{Left: unit.Dp(12), Right: unit.Dp(12)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				l, _ := (&textModel{content: label, style: BodyText, maxLines: 1}).label(th)
+				if selected {
+					l.Color = th.Palette.OnSecondaryContainer
+				}
+				return l.Layout(gtx)
+			})
+		})
+	})
+	if m.focus == i {
+		gtx.Execute(key.FocusCmd{Tag: item})
+		m.focus = -1
+	}
+	return dims
+}
+
+// dropdownState is the persistent state of a Dropdown (internal)
+type dropdownState struct {
+	*menu
+	field widget.Clickable
+}
+
+// Dropdown creates a field showing the selected item that opens a menu of items when tapped.
+// label turns an item into its display text; nil uses fmt.Sprint. It takes the TextField
+// options Hint, TextFieldID and OnChange, which receives the label of a newly chosen item.
+// Usage: Dropdown([]string{"Small", "Medium", "Large"}, size, nil, Hint("Size"))
+func Dropdown[T comparable](items []T, selected *State[T], label func(T) string, opts ...TextFieldOption) Widget {
+	d := newFieldModel(opts)
+	if label == nil {
+		label = func(item T) string { return fmt.Sprint(item) }
+	}
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = label(item)
+	}
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "dropdown", d.id, func() *dropdownState { return &dropdownState{menu: newMenu()} })
+
+		current := slices.Index(items, selected.Get())
+		if i := st.clicked(gtx, len(items)); i >= 0 {
+			selected.Set(items[i])
+			if i != current && d.onChange != nil {
+				d.onChange(labels[i])
+			}
+			current = i
+			st.open = false
+			gtx.Execute(key.FocusCmd{Tag: &st.field})
+		}
+		if st.field.Clicked(gtx) {
+			st.show(current, true)
+		}
+		wasOpen := st.open
+		st.update(gtx)
+		if wasOpen && !st.open {
+			gtx.Execute(key.FocusCmd{Tag: &st.field})
+		}
+
+		text, placeholder := d.hint, true
+		if current >= 0 {
+			text, placeholder = labels[current], false
+		}
+		dims := st.field.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layoutDropdownField(gtx, th, st.open || gtx.Focused(&st.field), func(gtx layout.Context) layout.Dimensions {
+				l, _ := (&textModel{content: text, style: BodyText, maxLines: 1}).label(th)
+				if placeholder {
+					l.Color = th.Palette.OnSurfaceVariant
+				}
+				return l.Layout(gtx)
+			}, func(gtx layout.Context) layout.Dimensions {
+				return drawMenuArrow(gtx, th, st.open)
+			})
+		})
+
+		st.layout(gtx, th, dims.Size, labels, current, false)
+		return dims
+	}
+}
+
+// autocompleteState is the persistent state of an Autocomplete (internal)
+type autocompleteState struct {
+	*menu
+	editor  widget.Editor
+	focused bool
+}
+
+// Autocomplete creates a text field bound to value that suggests matching options as the user types.
+// Options containing the typed text are listed, those starting with it first; tapping one
+// or pressing Enter fills it in. Any text can still be entered. It takes the TextField
+// options Hint, TextFieldID and OnChange.
+// Usage: Autocomplete(countries, country, Hint("Country"))
+func Autocomplete(options []string, value *State[string], opts ...TextFieldOption) Widget {
+	d := newFieldModel(opts)
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "autocomplete", d.id, func() *autocompleteState {
+			s := &autocompleteState{menu: newMenu()}
+			s.editor.SingleLine = true
+			s.editor.Submit = true
+			return s
+		})
+
+		choose := func(option string) {
+			st.editor.SetText(option)
+			st.editor.SetCaret(st.editor.Len(), st.editor.Len())
+			value.Set(option)
+			st.open = false
+		}
+
+		// Follow changes made to value elsewhere
+		if v := value.Get(); v != st.editor.Text() {
+			st.editor.SetText(v)
+		}
+
+		matches := matchOptions(options, st.editor.Text())
+		if i := st.clicked(gtx, len(matches)); i >= 0 {
+			choose(matches[i])
+		}
+		for {
+			event, ok := st.editor.Update(gtx)
+			if !ok {
+				break
+			}
+			switch event.(type) {
+			case widget.ChangeEvent:
+				value.Set(st.editor.Text())
+				if d.onChange != nil {
+					d.onChange(st.editor.Text())
+				}
+				matches = matchOptions(options, st.editor.Text())
+				st.show(0, false)
+			case widget.SubmitEvent:
+				if st.open && len(matches) > 0 {
+					choose(matches[0])
+				}
+			}
+		}
+
+		focused := gtx.Focused(&st.editor)
+		if focused && !st.focused {
+			st.show(0, false)
+		} else if !focused {
+			st.open = false
+		}
+		st.focused = focused
+		st.update(gtx)
+
+		// Nothing to suggest once the text is one of the options
+		if len(matches) == 1 && matches[0] == st.editor.Text() {
+			matches = nil
+		}
+
+		dims := layoutDropdownField(gtx, th, focused, func(gtx layout.Context) layout.Dimensions {
+			ed := material.Editor(th.Theme, &st.editor, d.hint)
+			ed.TextSize = th.Typography.Body.Size
+			ed.Color = th.Palette.OnSurface
+			ed.HintColor = th.Palette.OnSurfaceVariant
+			return ed.Layout(gtx)
+		}, nil)
+
+		st.layout(gtx, th, dims.Size, matches, -1, true)
+		return dims
+	}
+}
+
+// matchOptions returns the options containing text, ignoring case, with prefix matches first
+func matchOptions(options []string, text string) []string {
+	needle := strings.ToLower(text)
+	var prefix, contains []string
+	for _, option := range options {
+		o := strings.ToLower(option)
+		switch {
+		case strings.HasPrefix(o, needle):
+			prefix = append(prefix, option)
+		case strings.Contains(o, needle):
+			contains = append(contains, option)
+		}
+	}
+	return append(prefix, contains...)
+}
+
+// layoutDropdownField draws an outlined field around content and an optional trailing icon
+// The outline is drawn in the primary color while active
+func layoutDropdownField(gtx layout.Context, th *Theme, active bool, content, trailing layout.Widget) layout.Dimensions {
+	if gtx.Constraints.Max.X < unbounded {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	}
+	gtx.Constraints.Min.Y = max(gtx.Constraints.Min.Y, gtx.Dp(unit.Dp(56)))
+
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			size := gtx.Constraints.Min
+			border, width := th.Palette.Outline, gtx.Dp(unit.Dp(1))
+			if active {
+				border, width = th.Palette.Primary, gtx.Dp(unit.Dp(2))
+			}
+			rect := image.Rectangle{Max: size}
+			paint.FillShape(gtx.Ops, border, clip.Stroke{
+				Path:  clip.UniformRRect(rect.Inset((width+1)/2), gtx.Dp(unit.Dp(4))).Path(gtx.Ops),
+				Width: float32(width),
+			}.Op())
+			return layout.Dimensions{Size: size}
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Left: unit.Dp(16), Right: unit.Dp(12)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+					layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+						return layout.Inset{Top: unit.Dp(16), Bottom: unit.Dp(16)}.Layout(gtx, content)
+					}),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						if trailing == nil {
+							return layout.Dimensions{}
+						}
+						return trailing(gtx)
+					}),
+				)
+			})
+		}),
+	)
+}
+
+// drawMenuArrow draws the triangle at the end of a Dropdown field, pointing up while the menu is open
+func drawMenuArrow(gtx layout.Context, th *Theme, open bool) layout.Dimensions {
+	size := gtx.Dp(unit.Dp(24))
+	w, h := float32(gtx.Dp(unit.Dp(10))), float32(gtx.Dp(unit.Dp(5)))
+	x, y := (float32(size)-w)/2, (float32(size)-h)/2
+
+	var p clip.Path
+	p.Begin(gtx.Ops)
+	if open {
+		p.MoveTo(f32.Pt(x, y+h))
+		p.LineTo(f32.Pt(x+w/2, y))
+		p.LineTo(f32.Pt(x+w, y+h))
+	} else {
+		p.MoveTo(f32.Pt(x, y))
+		p.LineTo(f32.Pt(x+w/2, y+h))
+		p.LineTo(f32.Pt(x+w, y))
+	}
+	p.Close()
+	paint.FillShape(gtx.Ops, th.Palette.OnSurfaceVariant, clip.Outline{Path: p.End()}.Op())
+	return layout.Dimensions{Size: image.Pt(size, size)}
+}
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"slices"
+	"testing"
+
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+)
+
+func TestDropdownCallsOnChange(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+	var r input.Router
+	var frame int
+
+	size := NewState("Small")
+	var changes []string
+	w := Dropdown([]string{"Small", "Medium", "Large"}, size, nil,
+		TextFieldID("size"), OnChange(func(text string) { changes = append(changes, text) }))
+	routedFrame(s, &r, w, &frame)
+
+	st, _ := s.get("dropdown:size")
+	dropdown := st.(*dropdownState)
+	choose := func(i int) {
+		dropdown.show(0, false)
+		routedFrame(s, &r, w, &frame)
+		r.Source().Execute(key.FocusCmd{Tag: &dropdown.items[i]})
+		r.Queue(key.Event{Name: key.NameReturn, State: key.Press}, key.Event{Name: key.NameReturn, State: key.Release})
+		routedFrame(s, &r, w, &frame)
+	}
+
+	choose(1)
+	if size.Get() != "Medium" {
+		t.Fatalf("selected = %q, want %q", size.Get(), "Medium")
+	}
+	choose(1) // already selected
+	choose(2)
+	if want := []string{"Medium", "Large"}; !slices.Equal(changes, want) {
+		t.Errorf("OnChange got %q, want %q", changes, want)
+	}
+}
+
+func TestAutocompleteCallsOnChange(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+	var r input.Router
+	var frame int
+
+	country := NewState("")
+	var changes []string
+	w := Autocomplete([]string{"Germany", "Greece"}, country,
+		TextFieldID("country"), OnChange(func(text string) { changes = append(changes, text) }))
+	routedFrame(s, &r, w, &frame)
+
+	st, _ := s.get("autocomplete:country")
+	field := st.(*autocompleteState)
+	r.Source().Execute(key.FocusCmd{Tag: &field.editor})
+	r.Queue(key.EditEvent{Text: "Ger"})
+	routedFrame(s, &r, w, &frame)
+	r.Queue(key.Event{Name: key.NameReturn, State: key.Press})
+	routedFrame(s, &r, w, &frame)
+	routedFrame(s, &r, w, &frame)
+
+	if country.Get() != "Germany" {
+		t.Fatalf("value = %q, want %q", country.Get(), "Germany")
+	}
+	if want := []string{"Ger", "Germany"}; !slices.Equal(changes, want) {
+		t.Errorf("OnChange got %q, want %q", changes, want)
+	}
+}
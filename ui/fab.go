@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"image"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// FABLocation defines where a Scaffold places its floating action button
+type FABLocation int
+
+const (
+	// FABEndFloat floats the FAB over the bottom end corner of the body
+	FABEndFloat FABLocation = iota
+	// FABCenterFloat floats the FAB over the bottom centre of the body
+	FABCenterFloat
+	// FABCenterDocked centres the FAB on the top edge of the BottomNavigation bar
+	// Without a bottom bar on screen it floats like FABCenterFloat.
+	FABCenterDocked
+)
+
+// FloatingActionButton creates a 56dp square button for a Scaffold's FAB slot
+// A nil icon draws a plus sign.
+// Usage: FAB(FloatingActionButton(nil, OnClick(compose)))
+func FloatingActionButton(icon Widget, opts ...ButtonOption) Widget {
+	if icon == nil {
+		icon = drawFABPlus
+	}
+	return fabButton(icon, "", opts)
+}
+
+// ExtendedFAB creates a floating action button showing a label after its icon
+// A nil icon leaves just the label.
+// Usage: FAB(ExtendedFAB(nil, "Compose", OnClick(compose)))
+func ExtendedFAB(icon Widget, label string, opts ...ButtonOption) Widget {
+	return fabButton(icon, label, opts)
+}
+
+// fabButton builds a floating action button, extended if it has a label
+func fabButton(icon Widget, label string, opts []ButtonOption) Widget {
+	b := &buttonModel{label: label}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	onClick := b.onClick // Capture the handler
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		clickable := getClickable(gtx, b.id)
+		for clickable.Clicked(gtx) {
+			if onClick != nil {
+				onClick()
+			}
+		}
+
+		gtx.Constraints.Min = image.Point{}
+		return layoutSurface(gtx, th, th.Palette.PrimaryContainer, unit.Dp(16), unit.Dp(3), func(gtx layout.Context) layout.Dimensions {
+			return clickable.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				height := gtx.Dp(unit.Dp(56))
+				iconSize := gtx.Dp(unit.Dp(24))
+
+				// Measure the label of an extended FAB
+				var labelSize image.Point
+				var labelCall op.CallOp
+				if b.label != "" {
+					medium := font.Medium
+					l, _ := (&textModel{content: b.label, style: BodyText, weight: &medium, maxLines: 1}).label(th)
+					l.Color = th.Palette.OnPrimaryContainer
+					lgtx := gtx
+					lgtx.Constraints = layout.Constraints{Max: image.Pt(unbounded, height)}
+					macro := op.Record(gtx.Ops)
+					labelSize = l.Layout(lgtx).Size
+					labelCall = macro.Stop()
+				}
+
+				size := image.Pt(height, height)
+				iconX := (height - iconSize) / 2
+				labelX := gtx.Dp(unit.Dp(20))
+				if b.label != "" {
+					if icon != nil {
+						iconX = gtx.Dp(unit.Dp(16))
+						labelX = iconX + iconSize + gtx.Dp(unit.Dp(12))
+					}
+					size.X = max(labelX+labelSize.X+gtx.Dp(unit.Dp(20)), gtx.Dp(unit.Dp(80)))
+				}
+
+				if clickable.Hovered() || gtx.Focused(clickable) {
+					paint.FillShape(gtx.Ops, withAlpha(th.Palette.OnPrimaryContainer, 0x14), clip.Rect{Max: size}.Op())
+				}
+				if icon != nil {
+					layoutIcon(gtx, th, icon, image.Rectangle{Max: image.Pt(iconSize, iconSize)}.Add(image.Pt(iconX, (height-iconSize)/2)))
+				}
+				if b.label != "" {
+					off := op.Offset(image.Pt(labelX, (height-labelSize.Y)/2)).Push(gtx.Ops)
+					labelCall.Add(gtx.Ops)
+					off.Pop()
+				}
+				return layout.Dimensions{Size: size}
+			})
+		})
+	}
+}
+
+// drawFABPlus draws the plus sign of a FloatingActionButton without an icon
+func drawFABPlus(gtx layout.Context, th *Theme) layout.Dimensions {
+	size := gtx.Dp(unit.Dp(24))
+	length, width := gtx.Dp(unit.Dp(14)), gtx.Dp(unit.Dp(2))
+	a, b := (size-length)/2, (size-width)/2
+	paint.FillShape(gtx.Ops, th.Palette.OnPrimaryContainer, clip.Rect{Min: image.Pt(a, b), Max: image.Pt(a+length, b+width)}.Op())
+	paint.FillShape(gtx.Ops, th.Palette.OnPrimaryContainer, clip.Rect{Min: image.Pt(b, a), Max: image.Pt(b+width, a+length)}.Op())
+	return layout.Dimensions{Size: image.Pt(size, size)}
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// unbounded matches the maximum size layout.List hands its children along the scroll axis
+const unbounded = 1e6
+
+// flexModel holds the configuration shared by Column and Row (internal)
+type flexModel struct {
+	axis       layout.Axis
+	spacing    unit.Dp
+	mainAlign  MainAxisAlignment
+	crossAlign CrossAxisAlignment
+	children   []any // Can be Widget or FlexWidget
+}
+
+// flexSpacing maps a MainAxisAlignment onto Gio's Flex spacing
+func flexSpacing(align MainAxisAlignment) layout.Spacing {
+	switch align {
+	case MainAxisCenter:
+		return layout.SpaceSides
+	case MainAxisEnd:
+		return layout.SpaceStart
+	case MainAxisSpaceBetween:
+		return layout.SpaceBetween
+	case MainAxisSpaceAround:
+		return layout.SpaceAround
+	case MainAxisSpaceEvenly:
+		return layout.SpaceEvenly
+	default:
+		return layout.SpaceEnd
+	}
+}
+
+// flexAlignment maps a CrossAxisAlignment onto Gio's Flex alignment
+// Stretch is handled by tightening the children's constraints instead
+func flexAlignment(align CrossAxisAlignment) layout.Alignment {
+	switch align {
+	case CrossAxisCenter:
+		return layout.Middle
+	case CrossAxisEnd:
+		return layout.End
+	case CrossAxisBaseline:
+		return layout.Baseline
+	default:
+		return layout.Start
+	}
+}
+
+// layout lays out the children along the axis, honouring spacing and alignment
+func (f *flexModel) layout(gtx layout.Context, th *Theme) layout.Dimensions {
+	// Distributing free space only makes sense if the flex fills its main axis
+	if f.mainAlign != MainAxisStart {
+		if f.axis == layout.Vertical && gtx.Constraints.Max.Y < unbounded {
+			gtx.Constraints.Min.Y = gtx.Constraints.Max.Y
+		}
+		if f.axis == layout.Horizontal && gtx.Constraints.Max.X < unbounded {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		}
+	}
+
+	stretch := f.crossAlign == CrossAxisStretch
+	gap := gtx.Dp(f.spacing)
+	pos := scopedContainer(gtx, "flex")
+
+	flexChildren := make([]layout.FlexChild, 0, len(f.children)*2)
+	for i, child := range f.children {
+		// Spacing before rigid children is folded into the child so that
+		// SpaceAround and SpaceEvenly only see the real children
+		leading := 0
+		if i > 0 {
+			leading = gap
+		}
+
+		switch w := child.(type) {
+		case FlexWidget:
+			// Flexible child (Spacer or Expanded)
+			if leading > 0 {
+				flexChildren = append(flexChildren, layout.Rigid(f.spacer(leading)))
+			}
+			widget := w.Widget
+			flexChildren = append(flexChildren, layout.Flexed(w.Flex, func(gtx layout.Context) layout.Dimensions {
+				if stretch {
+					gtx = f.stretch(gtx)
+				}
+				return scopedChild(gtx, pos, i, func() layout.Dimensions {
+					return widget(gtx, th)
+				})
+			}))
+		case Widget:
+			// Regular rigid child
+			widget := w
+			flexChildren = append(flexChildren, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if stretch {
+					gtx = f.stretch(gtx)
+				}
+				return scopedChild(gtx, pos, i, func() layout.Dimensions {
+					if leading == 0 {
+						return widget(gtx, th)
+					}
+					return f.lead(gtx, leading, func(gtx layout.Context) layout.Dimensions {
+						return widget(gtx, th)
+					})
+				})
+			}))
+		}
+	}
+
+	return layout.Flex{
+		Axis:      f.axis,
+		Spacing:   flexSpacing(f.mainAlign),
+		Alignment: flexAlignment(f.crossAlign),
+	}.Layout(gtx, flexChildren...)
+}
+
+// spacer returns a fixed gap along the main axis
+func (f *flexModel) spacer(px int) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: f.axis.Convert(image.Pt(px, 0))}
+	}
+}
+
+// lead lays out w after a gap of px along the main axis
+func (f *flexModel) lead(gtx layout.Context, px int, w layout.Widget) layout.Dimensions {
+	gap := f.axis.Convert(image.Pt(px, 0))
+	cs := gtx.Constraints
+	gtx.Constraints.Min = image.Pt(max(cs.Min.X-gap.X, 0), max(cs.Min.Y-gap.Y, 0))
+	gtx.Constraints.Max = image.Pt(max(cs.Max.X-gap.X, 0), max(cs.Max.Y-gap.Y, 0))
+
+	stack := op.Offset(gap).Push(gtx.Ops)
+	dims := w(gtx)
+	stack.Pop()
+
+	dims.Size = dims.Size.Add(gap)
+	return dims
+}
+
+// stretch forces a child to fill the cross axis
+func (f *flexModel) stretch(gtx layout.Context) layout.Context {
+	if f.axis == layout.Vertical && gtx.Constraints.Max.X < unbounded {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	}
+	if f.axis == layout.Horizontal && gtx.Constraints.Max.Y < unbounded {
+		gtx.Constraints.Min.Y = gtx.Constraints.Max.Y
+	}
+	return gtx
+}
@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/layout"
+)
+
+func TestFlexAlignmentMapping(t *testing.T) {
+	spacing := map[MainAxisAlignment]layout.Spacing{
+		MainAxisStart:        layout.SpaceEnd,
+		MainAxisCenter:       layout.SpaceSides,
+		MainAxisEnd:          layout.SpaceStart,
+		MainAxisSpaceBetween: layout.SpaceBetween,
+		MainAxisSpaceAround:  layout.SpaceAround,
+		MainAxisSpaceEvenly:  layout.SpaceEvenly,
+	}
+	for align, want := range spacing {
+		if got := flexSpacing(align); got != want {
+			t.Errorf("flexSpacing(%d) = %v, want %v", align, got, want)
+		}
+	}
+
+	alignment := map[CrossAxisAlignment]layout.Alignment{
+		CrossAxisStart:    layout.Start,
+		CrossAxisCenter:   layout.Middle,
+		CrossAxisEnd:      layout.End,
+		CrossAxisStretch:  layout.Start,
+		CrossAxisBaseline: layout.Baseline,
+	}
+	for align, want := range alignment {
+		if got := flexAlignment(align); got != want {
+			t.Errorf("flexAlignment(%d) = %v, want %v", align, got, want)
+		}
+	}
+}
+
+// loose lets w pick any size up to the 400x300 test viewport
+func loose(w Widget) Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		gtx.Constraints.Min = image.Point{}
+		return w(gtx, th)
+	}
+}
+
+// minProbe records the minimum constraints it is laid out with and takes a 20x20 size
+func minProbe(dst *image.Point) Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		*dst = gtx.Constraints.Min
+		return layout.Dimensions{Size: gtx.Constraints.Constrain(image.Pt(20, 20))}
+	}
+}
+
+func TestFlexStretchFillsCrossAxis(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+
+	tests := []struct {
+		name    string
+		flex    func(child Widget, stretch bool) Widget
+		stretch image.Point
+	}{
+		{"column", func(child Widget, stretch bool) Widget {
+			align := CrossAxisStart
+			if stretch {
+				align = CrossAxisStretch
+			}
+			return Column([]any{child}, CrossAxis(align))
+		}, image.Pt(400, 0)},
+		{"row", func(child Widget, stretch bool) Widget {
+			align := CrossAxisStart
+			if stretch {
+				align = CrossAxisStretch
+			}
+			return Row([]any{child}, RowCrossAxis(align))
+		}, image.Pt(0, 300)},
+	}
+	for _, tt := range tests {
+		var min image.Point
+		layoutFrames(s, loose(tt.flex(minProbe(&min), false)), 1)
+		if min != (image.Point{}) {
+			t.Errorf("%s: child min constraints without stretch = %v, want 0x0", tt.name, min)
+		}
+		layoutFrames(s, loose(tt.flex(minProbe(&min), true)), 1)
+		if min != tt.stretch {
+			t.Errorf("%s: child min constraints with stretch = %v, want %v", tt.name, min, tt.stretch)
+		}
+	}
+}
+
+func TestFlexMainAxisAlignmentFillsMainAxis(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+
+	var dims layout.Dimensions
+	measure := func(w Widget) Widget {
+		return func(gtx layout.Context, th *Theme) layout.Dimensions {
+			dims = w(gtx, th)
+			return dims
+		}
+	}
+	var min image.Point
+	box := minProbe(&min)
+
+	tests := []struct {
+		name string
+		w    Widget
+		want image.Point
+	}{
+		{"column start", Column([]any{box}), image.Pt(20, 20)},
+		{"column center", Column([]any{box}, MainAxis(MainAxisCenter)), image.Pt(20, 300)},
+		{"column space evenly", Column([]any{box}, MainAxis(MainAxisSpaceEvenly)), image.Pt(20, 300)},
+		{"row start", Row([]any{box}), image.Pt(20, 20)},
+		{"row end", Row([]any{box}, RowMainAxis(MainAxisEnd)), image.Pt(400, 20)},
+		{"row space between", Row([]any{box}, RowMainAxis(MainAxisSpaceBetween)), image.Pt(400, 20)},
+	}
+	for _, tt := range tests {
+		layoutFrames(s, loose(measure(tt.w)), 1)
+		if dims.Size != tt.want {
+			t.Errorf("%s: size = %v, want %v", tt.name, dims.Size, tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"gioui.org/font"
+	"gioui.org/font/gofont"
+	"gioui.org/font/opentype"
+	"gioui.org/text"
+)
+
+// LoadFont registers a TrueType or OpenType font under a family name, so Text can use it with FontFamily.
+// Load each style and weight of a family separately; themes pick the fonts up on their next frame.
+// Usage: LoadFont("Inter", interBoldTTF, font.Regular, font.Bold)
+func LoadFont(family string, data []byte, style font.Style, weight font.Weight) error {
+	face, err := opentype.Parse(data)
+	if err != nil {
+		return fmt.Errorf("ui: load font %q: %w", family, err)
+	}
+
+	registerFonts(font.FontFace{
+		Font: font.Font{Typeface: font.Typeface(family), Style: style, Weight: weight},
+		Face: face,
+	})
+	return nil
+}
+
+// LoadFontDir registers every .ttf, .otf and .ttc font in fsys, including subdirectories.
+// Family, style and weight are read from the font files themselves.
+// Usage: LoadFontDir(fonts) with `//go:embed fonts` on `var fonts embed.FS`
+func LoadFontDir(fsys fs.FS) error {
+	var faces []font.FontFace
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch strings.ToLower(path.Ext(name)) {
+		case ".ttf", ".otf", ".ttc", ".otc":
+		default:
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		collection, err := opentype.ParseCollection(data)
+		if err != nil {
+			return fmt.Errorf("ui: load font %s: %w", name, err)
+		}
+		faces = append(faces, collection...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	registerFonts(faces...)
+	return nil
+}
+
+// fonts holds the faces registered with LoadFont and the shaper built from them.
+// Themes start with a placeholder shaper, swapped for the shared one at layout,
+// after the window exists (some platforms can't load system fonts before that).
+var (
+	fontMu      sync.Mutex
+	fontFaces   []font.FontFace
+	fontGen     int                          // bumped on every registration
+	fontShapers = make(map[*text.Shaper]int) // shapers owned by themes and the generation they include
+	fontShaper  *text.Shaper                 // shaper of the current generation, once built
+)
+
+// registerFonts adds faces to the registry
+func registerFonts(faces ...font.FontFace) {
+	fontMu.Lock()
+	defer fontMu.Unlock()
+
+	fontFaces = append(fontFaces, faces...)
+	fontGen++
+}
+
+// newThemeShaper returns a placeholder shaper for a new theme
+func newThemeShaper() *text.Shaper {
+	fontMu.Lock()
+	defer fontMu.Unlock()
+
+	s := new(text.Shaper)
+	fontShapers[s] = -1
+	return s
+}
+
+// updateShaper gives th the shaper holding every registered font
+// Shapers set on a theme by hand are left alone
+func updateShaper(th *Theme) {
+	if th == nil || th.Theme == nil {
+		return
+	}
+
+	fontMu.Lock()
+	defer fontMu.Unlock()
+
+	gen, owned := fontShapers[th.Shaper]
+	if !owned || gen == fontGen {
+		return
+	}
+	if fontShaper == nil || fontShapers[fontShaper] != fontGen {
+		// The Go fonts come first so they stay the default face
+		collection := slices.Concat(gofont.Collection(), fontFaces)
+		fontShaper = text.NewShaper(text.WithCollection(collection))
+		fontShapers[fontShaper] = fontGen
+	}
+	if gen == -1 {
+		delete(fontShapers, th.Shaper)
+	}
+	th.Shaper = fontShaper
+}
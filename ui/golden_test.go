@@ -0,0 +1,84 @@
+package ui_test
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/markschellhas/linnui/ui"
+	"github.com/markschellhas/linnui/uitest"
+)
+
+var update = flag.Bool("update", false, "rewrite golden images in testdata")
+
+// goldenTolerance absorbs antialiasing differences between rasterizers
+const goldenTolerance = 8
+
+func TestGoldenContainerBorders(t *testing.T) {
+	red := color.NRGBA{R: 0xd0, G: 0x30, B: 0x30, A: 0xff}
+	box := ui.SizedBox(ui.Width(80), ui.Height(50))
+	tests := []struct {
+		name string
+		opts []any
+	}{
+		{"container_border", []any{ui.Border(ui.BorderAll(2, red))}},
+		{"container_border_rounded", []any{ui.Border(ui.BorderAll(2, red)), ui.BorderRadius(12)}},
+		{"container_border_background", []any{ui.Border(ui.BorderAll(4, red)), ui.BorderRadius(8), ui.Background(ui.Light.Palette.PrimaryContainer)}},
+		{"container_shadow", []any{ui.Background(ui.Light.Palette.Surface), ui.BorderRadius(8), ui.Shadow(4)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := ui.Center(ui.Container(append(tt.opts, box)...))
+			uitest.Golden(t, tt.name, w, image.Pt(120, 90), uitest.Tolerance(goldenTolerance))
+		})
+	}
+}
+
+func TestGoldenButtonVariants(t *testing.T) {
+	tests := []struct {
+		name    string
+		variant ui.ButtonVariant
+	}{
+		{"button_filled", ui.Filled},
+		{"button_outlined", ui.Outlined},
+		{"button_text", ui.TextButton},
+		{"button_elevated", ui.Elevated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := ui.Center(ui.Button("OK", ui.Variant(tt.variant)))
+			uitest.Golden(t, tt.name, w, image.Pt(120, 80), uitest.Tolerance(goldenTolerance))
+		})
+	}
+}
+
+func TestGoldenImageFit(t *testing.T) {
+	// A wide image with distinct halves shows how each mode scales and crops it
+	src := image.NewNRGBA(image.Rect(0, 0, 40, 20))
+	for y := range 20 {
+		for x := range 40 {
+			c := color.NRGBA{R: 0x30, G: 0x60, B: 0xd0, A: 0xff}
+			if x >= 20 {
+				c = color.NRGBA{R: 0xe0, G: 0xa0, B: 0x20, A: 0xff}
+			}
+			src.SetNRGBA(x, y, c)
+		}
+	}
+
+	tests := []struct {
+		name string
+		fit  ui.ImageFit
+	}{
+		{"image_fit_contain", ui.FitContain},
+		{"image_fit_cover", ui.FitCover},
+		{"image_fit_fill", ui.FitFill},
+		{"image_fit_none", ui.FitNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := ui.Center(ui.ImageFromImage(src, ui.ImageWidth(60), ui.ImageHeight(60), ui.Fit(tt.fit)))
+			uitest.Golden(t, tt.name, w, image.Pt(80, 80), uitest.Tolerance(goldenTolerance))
+		})
+	}
+}
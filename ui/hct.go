@@ -0,0 +1,356 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+)
+
+// This file implements the HCT (hue, chroma, tone) color space used by
+// Material 3 to derive tonal palettes. Hue and chroma come from CAM16,
+// tone is CIE L*. The math follows Material Color Utilities.
+
+// viewingConditions holds the CAM16 parameters for a viewing environment (internal)
+type viewingConditions struct {
+	n, aw, nbb, ncb, c, nc, fl, fLRoot, z float64
+	rgbD                                  [3]float64
+}
+
+// defaultViewingConditions matches sRGB on a mid-grey background
+var defaultViewingConditions = newViewingConditions()
+
+// newViewingConditions computes the conditions for D65 white, 200/π lux adapting
+// luminance, L* 50 background and average surround
+func newViewingConditions() viewingConditions {
+	whitePoint := [3]float64{95.047, 100.0, 108.883}
+	adaptingLuminance := 200.0 / math.Pi * yFromLstar(50) / 100
+	backgroundLstar := 50.0
+	surround := 2.0
+
+	rW := whitePoint[0]*0.401288 + whitePoint[1]*0.650173 + whitePoint[2]*-0.051461
+	gW := whitePoint[0]*-0.250268 + whitePoint[1]*1.204414 + whitePoint[2]*0.045854
+	bW := whitePoint[0]*-0.002079 + whitePoint[1]*0.048952 + whitePoint[2]*0.953127
+
+	f := 0.8 + surround/10
+	var c float64
+	if f >= 0.9 {
+		c = lerp(0.59, 0.69, (f-0.9)*10)
+	} else {
+		c = lerp(0.525, 0.59, (f-0.8)*10)
+	}
+	d := f * (1 - (1/3.6)*math.Exp((-adaptingLuminance-42)/92))
+	d = math.Max(0, math.Min(1, d))
+
+	rgbD := [3]float64{
+		d*(100/rW) + 1 - d,
+		d*(100/gW) + 1 - d,
+		d*(100/bW) + 1 - d,
+	}
+
+	k := 1 / (5*adaptingLuminance + 1)
+	k4 := k * k * k * k
+	k4F := 1 - k4
+	fl := k4*adaptingLuminance + 0.1*k4F*k4F*math.Cbrt(5*adaptingLuminance)
+	n := yFromLstar(backgroundLstar) / whitePoint[1]
+	z := 1.48 + math.Sqrt(n)
+	nbb := 0.725 / math.Pow(n, 0.2)
+
+	rgbAFactors := [3]float64{
+		math.Pow(fl*rgbD[0]*rW/100, 0.42),
+		math.Pow(fl*rgbD[1]*gW/100, 0.42),
+		math.Pow(fl*rgbD[2]*bW/100, 0.42),
+	}
+	var rgbA [3]float64
+	for i, factor := range rgbAFactors {
+		rgbA[i] = 400 * factor / (factor + 27.13)
+	}
+	aw := (2*rgbA[0] + rgbA[1] + 0.05*rgbA[2]) * nbb
+
+	return viewingConditions{
+		n:      n,
+		aw:     aw,
+		nbb:    nbb,
+		ncb:    nbb,
+		c:      c,
+		nc:     f,
+		fl:     fl,
+		fLRoot: math.Pow(fl, 0.25),
+		z:      z,
+		rgbD:   rgbD,
+	}
+}
+
+// cam16 is a color in the CAM16 color appearance model (internal)
+type cam16 struct {
+	hue, chroma, j      float64
+	jstar, astar, bstar float64
+}
+
+// cam16FromColor converts an sRGB color to CAM16
+func cam16FromColor(c color.NRGBA) cam16 {
+	vc := defaultViewingConditions
+	r, g, b := linearized(c.R), linearized(c.G), linearized(c.B)
+
+	x := 0.41233895*r + 0.35762064*g + 0.18051042*b
+	y := 0.2126*r + 0.7152*g + 0.0722*b
+	z := 0.01932141*r + 0.11916382*g + 0.95034478*b
+
+	rC := 0.401288*x + 0.650173*y - 0.051461*z
+	gC := -0.250268*x + 1.204414*y + 0.045854*z
+	bC := -0.002079*x + 0.048952*y + 0.953127*z
+
+	rA := adapt(vc.rgbD[0]*rC, vc.fl)
+	gA := adapt(vc.rgbD[1]*gC, vc.fl)
+	bA := adapt(vc.rgbD[2]*bC, vc.fl)
+
+	a := (11*rA - 12*gA + bA) / 11
+	bb := (rA + gA - 2*bA) / 9
+	u := (20*rA + 20*gA + 21*bA) / 20
+	p2 := (40*rA + 20*gA + bA) / 20
+
+	hue := sanitizeDegrees(math.Atan2(bb, a) * 180 / math.Pi)
+	ac := p2 * vc.nbb
+	j := 100 * math.Pow(ac/vc.aw, vc.c*vc.z)
+
+	huePrime := hue
+	if hue < 20.14 {
+		huePrime = hue + 360
+	}
+	eHue := 0.25 * (math.Cos(huePrime*math.Pi/180+2) + 3.8)
+	p1 := 50000.0 / 13 * eHue * vc.nc * vc.ncb
+	t := p1 * math.Hypot(a, bb) / (u + 0.305)
+	alpha := math.Pow(1.64-math.Pow(0.29, vc.n), 0.73) * math.Pow(t, 0.9)
+	chroma := alpha * math.Sqrt(j/100)
+
+	return newCam16(j, chroma, hue)
+}
+
+// cam16FromJCh creates a CAM16 color from lightness, chroma and hue
+func cam16FromJCh(j, chroma, hue float64) cam16 {
+	return newCam16(j, chroma, hue)
+}
+
+// newCam16 fills in the CAM16-UCS coordinates used for color distances
+func newCam16(j, chroma, hue float64) cam16 {
+	vc := defaultViewingConditions
+	m := chroma * vc.fLRoot
+	mstar := 1 / 0.0228 * math.Log1p(0.0228*m)
+	hueRad := hue * math.Pi / 180
+	return cam16{
+		hue:    hue,
+		chroma: chroma,
+		j:      j,
+		jstar:  (1 + 100*0.007) * j / (1 + 0.007*j),
+		astar:  mstar * math.Cos(hueRad),
+		bstar:  mstar * math.Sin(hueRad),
+	}
+}
+
+// distance returns the perceptual distance between two CAM16 colors
+func (c cam16) distance(other cam16) float64 {
+	dJ := c.jstar - other.jstar
+	dA := c.astar - other.astar
+	dB := c.bstar - other.bstar
+	return 1.41 * math.Pow(math.Sqrt(dJ*dJ+dA*dA+dB*dB), 0.63)
+}
+
+// toColor converts the CAM16 color to sRGB, clipping out-of-gamut colors
+func (c cam16) toColor() color.NRGBA {
+	vc := defaultViewingConditions
+	alpha := 0.0
+	if c.chroma != 0 && c.j != 0 {
+		alpha = c.chroma / math.Sqrt(c.j/100)
+	}
+	t := math.Pow(alpha/math.Pow(1.64-math.Pow(0.29, vc.n), 0.73), 1/0.9)
+	hRad := c.hue * math.Pi / 180
+
+	eHue := 0.25 * (math.Cos(hRad+2) + 3.8)
+	ac := vc.aw * math.Pow(c.j/100, 1/vc.c/vc.z)
+	p1 := eHue * (50000.0 / 13) * vc.nc * vc.ncb
+	p2 := ac / vc.nbb
+
+	hSin, hCos := math.Sin(hRad), math.Cos(hRad)
+	gamma := 23 * (p2 + 0.305) * t / (23*p1 + 11*t*hCos + 108*t*hSin)
+	a := gamma * hCos
+	b := gamma * hSin
+
+	rA := (460*p2 + 451*a + 288*b) / 1403
+	gA := (460*p2 - 891*a - 261*b) / 1403
+	bA := (460*p2 - 220*a - 6300*b) / 1403
+
+	rF := unadapt(rA, vc.fl) / vc.rgbD[0]
+	gF := unadapt(gA, vc.fl) / vc.rgbD[1]
+	bF := unadapt(bA, vc.fl) / vc.rgbD[2]
+
+	x := 1.86206786*rF - 1.01125463*gF + 0.14918677*bF
+	y := 0.38752654*rF + 0.62144744*gF - 0.00897398*bF
+	z := -0.01584150*rF - 0.03412294*gF + 1.04996444*bF
+	return colorFromXYZ(x, y, z)
+}
+
+// hctColor returns the sRGB color closest to the given hue, chroma and tone.
+// When the chroma is out of gamut for that hue and tone, the highest
+// achievable chroma is used instead.
+func hctColor(hue, chroma, tone float64) color.NRGBA {
+	if chroma < 1 || math.Round(tone) <= 0 || math.Round(tone) >= 100 {
+		return colorFromLstar(tone)
+	}
+	hue = sanitizeDegrees(hue)
+
+	high, mid, low := chroma, chroma, 0.0
+	firstLoop := true
+	var answer *cam16
+	for math.Abs(low-high) >= 0.4 {
+		candidate := findCamByJ(hue, mid, tone)
+		if firstLoop {
+			if candidate != nil {
+				return candidate.toColor()
+			}
+			firstLoop = false
+			mid = low + (high-low)/2
+			continue
+		}
+		if candidate == nil {
+			high = mid
+		} else {
+			answer = candidate
+			low = mid
+		}
+		mid = low + (high-low)/2
+	}
+	if answer == nil {
+		return colorFromLstar(tone)
+	}
+	return answer.toColor()
+}
+
+// findCamByJ searches CAM16 lightness for a color with the given hue and
+// chroma whose L* matches tone, returning nil if none is close enough
+func findCamByJ(hue, chroma, tone float64) *cam16 {
+	low, high := 0.0, 100.0
+	bestdL, bestdE := 1000.0, 1000.0
+	var best *cam16
+	for math.Abs(low-high) > 0.01 {
+		mid := low + (high-low)/2
+		clipped := cam16FromJCh(mid, chroma, hue).toColor()
+		clippedLstar := lstarFromColor(clipped)
+		dL := math.Abs(tone - clippedLstar)
+		if dL < 0.2 {
+			camClipped := cam16FromColor(clipped)
+			dE := camClipped.distance(cam16FromJCh(camClipped.j, camClipped.chroma, hue))
+			if dE <= 1 && dE <= bestdE {
+				bestdL, bestdE = dL, dE
+				best = &camClipped
+			}
+		}
+		if bestdL == 0 && bestdE == 0 {
+			break
+		}
+		if clippedLstar < tone {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return best
+}
+
+// adapt applies CAM16 chromatic adaptation to a cone response
+func adapt(component, fl float64) float64 {
+	af := math.Pow(fl*math.Abs(component)/100, 0.42)
+	return math.Copysign(400*af/(af+27.13), component)
+}
+
+// unadapt inverts adapt
+func unadapt(adapted, fl float64) float64 {
+	base := math.Max(0, 27.13*math.Abs(adapted)/(400-math.Abs(adapted)))
+	return math.Copysign(100/fl*math.Pow(base, 1/0.42), adapted)
+}
+
+// linearized converts an sRGB channel to linear RGB in the range 0..100
+func linearized(channel uint8) float64 {
+	n := float64(channel) / 255
+	if n <= 0.040449936 {
+		return n / 12.92 * 100
+	}
+	return math.Pow((n+0.055)/1.055, 2.4) * 100
+}
+
+// delinearized converts a linear RGB component in the range 0..100 to an sRGB channel
+func delinearized(component float64) uint8 {
+	n := component / 100
+	var v float64
+	if n <= 0.0031308 {
+		v = n * 12.92
+	} else {
+		v = 1.055*math.Pow(n, 1/2.4) - 0.055
+	}
+	return uint8(math.Max(0, math.Min(255, math.Round(v*255))))
+}
+
+// colorFromXYZ converts CIE XYZ (0..100) to sRGB, clamping out-of-gamut channels
+func colorFromXYZ(x, y, z float64) color.NRGBA {
+	r := 3.2413774792388685*x - 1.5376652402851851*y - 0.49885366846268053*z
+	g := -0.9692436362808796*x + 1.8759675015077202*y + 0.04155505740717559*z
+	b := 0.05562093689691305*x - 0.20395525564996979*y + 1.0571799111220335*z
+	return color.NRGBA{R: delinearized(r), G: delinearized(g), B: delinearized(b), A: 255}
+}
+
+// colorFromLstar returns the grey with the given L*
+func colorFromLstar(lstar float64) color.NRGBA {
+	v := delinearized(yFromLstar(lstar))
+	return color.NRGBA{R: v, G: v, B: v, A: 255}
+}
+
+// lstarFromColor returns the L* (tone) of an sRGB color
+func lstarFromColor(c color.NRGBA) float64 {
+	y := 0.2126*linearized(c.R) + 0.7152*linearized(c.G) + 0.0722*linearized(c.B)
+	return 116*labF(y/100) - 16
+}
+
+// yFromLstar converts L* to relative luminance in the range 0..100
+func yFromLstar(lstar float64) float64 {
+	return 100 * labInvF((lstar+16)/116)
+}
+
+// labF is the CIE L*a*b* companding function
+func labF(t float64) float64 {
+	const e, kappa = 216.0 / 24389, 24389.0 / 27
+	if t > e {
+		return math.Cbrt(t)
+	}
+	return (kappa*t + 16) / 116
+}
+
+// labInvF inverts labF
+func labInvF(ft float64) float64 {
+	const e, kappa = 216.0 / 24389, 24389.0 / 27
+	ft3 := ft * ft * ft
+	if ft3 > e {
+		return ft3
+	}
+	return (116*ft - 16) / kappa
+}
+
+// sanitizeDegrees wraps an angle into the range [0, 360)
+func sanitizeDegrees(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
+
+// lerp linearly interpolates between a and b
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// tonalPalette produces colors of one hue and chroma at any tone (internal)
+type tonalPalette struct {
+	hue, chroma float64
+}
+
+// tone returns the palette's color at the given tone (0 black .. 100 white)
+func (p tonalPalette) tone(t float64) color.NRGBA {
+	return hctColor(p.hue, p.chroma, t)
+}
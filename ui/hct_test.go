@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"testing"
+)
+
+// hex parses a #RRGGBB color
+func hex(t *testing.T, s string) color.NRGBA {
+	t.Helper()
+	var r, g, b uint8
+	if _, err := fmt.Sscanf(s, "#%02x%02x%02x", &r, &g, &b); err != nil {
+		t.Fatalf("bad color %q: %v", s, err)
+	}
+	return color.NRGBA{R: r, G: g, B: b, A: 0xff}
+}
+
+func TestSchemeFromSeedMatchesMaterialBaseline(t *testing.T) {
+	// Reference colors of the Material 3 baseline scheme, which Material Color Utilities
+	// derives from #6750A4 with its core palette
+	scheme := SchemeFromSeed(hex(t, "#6750A4"), BrightnessLight)
+	tests := []struct {
+		role string
+		got  color.NRGBA
+		want string
+	}{
+		{"Primary", scheme.Primary, "#6750A4"},
+		{"OnPrimary", scheme.OnPrimary, "#FFFFFF"},
+		{"PrimaryContainer", scheme.PrimaryContainer, "#EADDFF"},
+		{"Secondary", scheme.Secondary, "#625B71"},
+		{"SecondaryContainer", scheme.SecondaryContainer, "#E8DEF8"},
+		{"Tertiary", scheme.Tertiary, "#7D5260"},
+		{"TertiaryContainer", scheme.TertiaryContainer, "#FFD8E4"},
+	}
+	for _, tt := range tests {
+		if want := hex(t, tt.want); tt.got != want {
+			t.Errorf("%s = #%02X%02X%02X, want %s", tt.role, tt.got.R, tt.got.G, tt.got.B, tt.want)
+		}
+	}
+}
@@ -180,7 +180,7 @@ func Image(path string, opts ...ImageOption) Widget {
 		offsetY := float32(displayHeight-finalHeight) / 2
 
 		// Apply transformation and draw
-		transform := f32.Affine2D{}.Offset(f32.Pt(offsetX, offsetY)).Scale(f32.Point{}, f32.Pt(scaleX, scaleY))
+		transform := f32.Affine2D{}.Scale(f32.Point{}, f32.Pt(scaleX, scaleY)).Offset(f32.Pt(offsetX, offsetY))
 		defer op.Affine(transform).Push(gtx.Ops).Pop()
 		imgOp.Add(gtx.Ops)
 		paint.PaintOp{}.Add(gtx.Ops)
@@ -286,7 +286,7 @@ func ImageFromImage(img image.Image, opts ...ImageOption) Widget {
 		offsetX := float32(displayWidth-finalWidth) / 2
 		offsetY := float32(displayHeight-finalHeight) / 2
 
-		transform := f32.Affine2D{}.Offset(f32.Pt(offsetX, offsetY)).Scale(f32.Point{}, f32.Pt(scaleX, scaleY))
+		transform := f32.Affine2D{}.Scale(f32.Point{}, f32.Pt(scaleX, scaleY)).Offset(f32.Pt(offsetX, offsetY))
 		defer op.Affine(transform).Push(gtx.Ops).Pop()
 		imgOp.Add(gtx.Ops)
 		paint.PaintOp{}.Add(gtx.Ops)
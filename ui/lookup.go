@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"gioui.org/widget"
+)
+
+// LookupButton returns the persistent clickable of the Button identified by opts
+// Pass the same ButtonID as the Button; the lookup fails until the Button has been built.
+// The package-level lookups search the window being laid out, else the last one laid out;
+// with several windows use the Scope methods instead.
+func LookupButton(opts ...ButtonOption) (*widget.Clickable, bool) {
+	return lookupState[*widget.Clickable]("button", buttonID(opts))
+}
+
+// LookupTextField returns the persistent editor of the TextField with the given ID
+func LookupTextField(id string) (*widget.Editor, bool) {
+	return lookupState[*widget.Editor]("textfield", id)
+}
+
+// LookupScrollView returns the persistent list of the ScrollView or ListView identified by opts
+func LookupScrollView(opts ...ScrollViewOption) (*widget.List, bool) {
+	return lookupState[*widget.List]("scroll", scrollID(opts))
+}
+
+// ButtonDescription returns the semantic description of the Button identified by opts in a scope
+// created with DescribeIDs, or "" without a ButtonID
+func ButtonDescription(opts ...ButtonOption) string {
+	return description("button", buttonID(opts))
+}
+
+// ScrollViewDescription returns the semantic description of the ScrollView or ListView
+// identified by opts in a scope created with DescribeIDs, or "" without a ScrollID
+func ScrollViewDescription(opts ...ScrollViewOption) string {
+	return description("scroll", scrollID(opts))
+}
+
+// LookupButton returns the clickable of the Button identified by opts in this scope
+func (s *Scope) LookupButton(opts ...ButtonOption) (*widget.Clickable, bool) {
+	return scopeLookup[*widget.Clickable](s, "button", buttonID(opts))
+}
+
+// LookupTextField returns the editor of the TextField with the given ID in this scope
+func (s *Scope) LookupTextField(id string) (*widget.Editor, bool) {
+	return scopeLookup[*widget.Editor](s, "textfield", id)
+}
+
+// LookupScrollView returns the list of the ScrollView or ListView identified by opts in this scope
+func (s *Scope) LookupScrollView(opts ...ScrollViewOption) (*widget.List, bool) {
+	return scopeLookup[*widget.List](s, "scroll", scrollID(opts))
+}
+
+// scopeLookup finds the state of the widget with an explicit id in s
+func scopeLookup[T any](s *Scope, kind, id string) (T, bool) {
+	if v, ok := s.get(kind + ":" + id); ok {
+		return v.(T), true
+	}
+	var zero T
+	return zero, false
+}
+
+// buttonID returns the ID set by the ButtonID option among opts
+func buttonID(opts []ButtonOption) string {
+	b := &buttonModel{}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b.id
+}
+
+// scrollID returns the ID set by the ScrollID option among opts
+func scrollID(opts []ScrollViewOption) string {
+	s := &scrollViewModel{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s.id
+}
@@ -0,0 +1,285 @@
+package ui
+
+import (
+	"image"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// MarkdownOption configures the Markdown widget
+type MarkdownOption func(*markdownModel)
+
+// OnLinkTap sets the handler called with the destination of a tapped link
+// Without a handler links are styled but not tappable
+func OnLinkTap(fn func(url string)) MarkdownOption {
+	return func(m *markdownModel) { m.onLink = fn }
+}
+
+// ImageDir sets the directory relative image paths are resolved against (defaults to the working directory)
+func ImageDir(dir string) MarkdownOption {
+	return func(m *markdownModel) { m.imageDir = dir }
+}
+
+// MarkdownScrollID sets a unique ID for the Markdown's scroll view (for state persistence)
+func MarkdownScrollID(id string) MarkdownOption {
+	return func(m *markdownModel) { m.scrollID = id }
+}
+
+// markdownModel holds Markdown configuration (internal)
+type markdownModel struct {
+	source   []byte
+	onLink   func(url string)
+	imageDir string
+	scrollID string
+}
+
+// markdownKey is what a Markdown's widgets are built from, apart from its link handler (internal)
+type markdownKey struct {
+	source   string
+	imageDir string
+	scrollID string
+	links    bool // links are tappable
+}
+
+// markdownState caches the widgets built for a Markdown until its source or options change,
+// so the source isn't parsed and its images aren't decoded on every frame (internal)
+type markdownState struct {
+	key   markdownKey
+	model *markdownModel // the model the widgets were built from
+	view  Widget
+}
+
+// Markdown renders CommonMark source as scrollable widgets.
+// Headings use the H1–H6 text styles, images are loaded from local files with Image.
+// The parsed source is kept while the widget stays on screen and rebuilt when the source changes.
+// Usage: Markdown(releaseNotes, OnLinkTap(openURL), ImageDir("docs"))
+func Markdown(source string, opts ...MarkdownOption) Widget {
+	m := &markdownModel{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	key := markdownKey{source: source, imageDir: m.imageDir, scrollID: m.scrollID, links: m.onLink != nil}
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "markdown", "", func() *markdownState { return new(markdownState) })
+		if st.view == nil || st.key != key {
+			m.source = []byte(source)
+			st.key, st.model, st.view = key, m, m.build()
+		}
+		st.model.onLink = m.onLink // link spans call the latest handler
+		return st.view(gtx, th)
+	}
+}
+
+// build parses the source and converts it into widgets
+func (m *markdownModel) build() Widget {
+	doc := goldmark.DefaultParser().Parse(text.NewReader(m.source))
+	body := Column(m.blocks(doc), Spacing(12), CrossAxis(CrossAxisStretch))
+	return ScrollView(Padding(InsetsAll(16), body), ScrollID(m.scrollID))
+}
+
+// blocks converts the block-level children of parent into widgets
+func (m *markdownModel) blocks(parent ast.Node) []any {
+	var out []any
+	for n := parent.FirstChild(); n != nil; n = n.NextSibling() {
+		switch n := n.(type) {
+		case *ast.Heading:
+			out = append(out, m.paragraph(n, Style(headingStyle(n.Level)))...)
+		case *ast.Paragraph, *ast.TextBlock:
+			out = append(out, m.paragraph(n)...)
+		case *ast.List:
+			out = append(out, m.list(n))
+		case *ast.FencedCodeBlock:
+			out = append(out, markdownCode(m.lines(n)))
+		case *ast.CodeBlock:
+			out = append(out, markdownCode(m.lines(n)))
+		case *ast.Blockquote:
+			out = append(out, markdownQuote(Column(m.blocks(n), Spacing(8), CrossAxis(CrossAxisStretch))))
+		case *ast.ThematicBreak:
+			out = append(out, markdownDivider)
+		case *ast.HTMLBlock:
+			// Raw HTML is not rendered
+		default:
+			out = append(out, m.blocks(n)...)
+		}
+	}
+	return out
+}
+
+// list lays out the items of a list next to their bullets or numbers
+func (m *markdownModel) list(n *ast.List) Widget {
+	var items []any
+	number := n.Start
+	for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+		marker := "•"
+		if n.IsOrdered() {
+			marker = strconv.Itoa(number) + string(n.Marker)
+			number++
+		}
+		items = append(items, Row([]any{
+			SizedBox(Width(24), Text(marker)),
+			Expanded(Column(m.blocks(item), Spacing(4), CrossAxis(CrossAxisStretch))),
+		}, RowSpacing(0)))
+	}
+	return Column(items, Spacing(4), CrossAxis(CrossAxisStretch))
+}
+
+// lines returns the raw text of a code block
+func (m *markdownModel) lines(n ast.Node) string {
+	var b strings.Builder
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		seg := lines.At(i)
+		b.Write(seg.Value(m.source))
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// paragraph converts inline content into RichText, splitting it around images
+func (m *markdownModel) paragraph(n ast.Node, opts ...SpanOption) []any {
+	p := &markdownParagraph{}
+	m.inlines(n, opts, "", p)
+	p.flush()
+	return p.out
+}
+
+// markdownParagraph collects the spans and images of one paragraph (internal)
+type markdownParagraph struct {
+	spans []Span
+	out   []any
+}
+
+// flush ends the current run of text
+func (p *markdownParagraph) flush() {
+	if len(p.spans) > 0 {
+		p.out = append(p.out, RichText(p.spans...))
+		p.spans = nil
+	}
+}
+
+// inlines walks inline nodes, accumulating styling options and the enclosing link destination
+func (m *markdownModel) inlines(parent ast.Node, opts []SpanOption, link string, p *markdownParagraph) {
+	for n := parent.FirstChild(); n != nil; n = n.NextSibling() {
+		switch n := n.(type) {
+		case *ast.Text:
+			s := string(n.Segment.Value(m.source))
+			if n.HardLineBreak() {
+				s += "\n"
+			} else if n.SoftLineBreak() {
+				s += " "
+			}
+			p.spans = append(p.spans, m.span(s, opts, link))
+		case *ast.String:
+			p.spans = append(p.spans, m.span(string(n.Value), opts, link))
+		case *ast.CodeSpan:
+			p.spans = append(p.spans, m.span(string(n.Text(m.source)), with(opts, Code()), link))
+		case *ast.Emphasis:
+			emphasis := Italic()
+			if n.Level >= 2 {
+				emphasis = Bold()
+			}
+			m.inlines(n, with(opts, emphasis), link, p)
+		case *ast.Link:
+			m.inlines(n, opts, string(n.Destination), p)
+		case *ast.AutoLink:
+			url := string(n.URL(m.source))
+			p.spans = append(p.spans, m.span(string(n.Label(m.source)), opts, url))
+		case *ast.Image:
+			p.flush()
+			p.out = append(p.out, Image(m.imagePath(string(n.Destination))))
+		case *ast.RawHTML:
+			// Raw HTML is not rendered
+		default:
+			m.inlines(n, opts, link, p)
+		}
+	}
+}
+
+// span creates a text span, or a link span inside a link
+func (m *markdownModel) span(s string, opts []SpanOption, link string) Span {
+	if link == "" {
+		return TextSpan(s, opts...)
+	}
+	var onTap func()
+	if m.onLink != nil {
+		onTap = func() { m.onLink(link) }
+	}
+	return LinkSpan(s, onTap, opts...)
+}
+
+// imagePath resolves an image destination against the image directory
+func (m *markdownModel) imagePath(dest string) string {
+	if m.imageDir == "" || filepath.IsAbs(dest) {
+		return dest
+	}
+	return filepath.Join(m.imageDir, filepath.FromSlash(dest))
+}
+
+// with returns opts plus opt without modifying opts
+func with(opts []SpanOption, opt SpanOption) []SpanOption {
+	return append(slices.Clip(opts), opt)
+}
+
+// headingStyle maps a Markdown heading level onto a text style
+func headingStyle(level int) TextStyle {
+	return []TextStyle{H1, H2, H3, H4, H5, H6}[min(max(level, 1), 6)-1]
+}
+
+// markdownCode draws a code block on a tinted, rounded background
+func markdownCode(code string) Widget {
+	content := Padding(InsetsAll(12), Text(code, Code()))
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		minWidth := gtx.Constraints.Min.X // fill the width when stretched
+		return layout.Stack{}.Layout(gtx,
+			layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+				rect := image.Rectangle{Max: gtx.Constraints.Min}
+				paint.FillShape(gtx.Ops, th.Palette.SurfaceContainerHighest, clip.UniformRRect(rect, gtx.Dp(8)).Op(gtx.Ops))
+				return layout.Dimensions{Size: rect.Max}
+			}),
+			layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min.X = minWidth
+				return content(gtx, th)
+			}),
+		)
+	}
+}
+
+// markdownQuote indents a block quote behind a vertical bar
+func markdownQuote(child Widget) Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		bar := gtx.Dp(unit.Dp(4))
+		indent := gtx.Dp(unit.Dp(16))
+
+		cgtx := gtx
+		cgtx.Constraints.Min.X = max(0, gtx.Constraints.Min.X-indent)
+		cgtx.Constraints.Max.X = max(0, gtx.Constraints.Max.X-indent)
+		off := op.Offset(image.Pt(indent, 0)).Push(gtx.Ops)
+		dims := child(cgtx, th)
+		off.Pop()
+
+		size := image.Pt(dims.Size.X+indent, dims.Size.Y)
+		paint.FillShape(gtx.Ops, th.Palette.OutlineVariant, clip.Rect{Max: image.Pt(bar, size.Y)}.Op())
+		return layout.Dimensions{Size: size}
+	}
+}
+
+// markdownDivider draws a thematic break as a thin line
+var markdownDivider Widget = func(gtx layout.Context, th *Theme) layout.Dimensions {
+	size := image.Pt(gtx.Constraints.Max.X, gtx.Dp(unit.Dp(1)))
+	if size.X >= unbounded {
+		size.X = gtx.Constraints.Min.X
+	}
+	paint.FillShape(gtx.Ops, th.Palette.OutlineVariant, clip.Rect{Max: size}.Op())
+	return layout.Dimensions{Size: size}
+}
@@ -0,0 +1,47 @@
+package ui
+
+import "testing"
+
+// markdownStates returns the Markdown caches in s
+func markdownStates(s *Scope) []*markdownState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []*markdownState
+	for _, e := range s.entries {
+		if st, ok := e.value.(*markdownState); ok {
+			out = append(out, st)
+		}
+	}
+	return out
+}
+
+func TestMarkdownCachesParsedSource(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+
+	var tapped string
+	layoutFrames(s, Markdown("# Notes\n\n[a](first)", OnLinkTap(func(string) { tapped = "old" })), 1)
+	states := markdownStates(s)
+	if len(states) != 1 {
+		t.Fatalf("found %d Markdown states, want 1", len(states))
+	}
+	st := states[0]
+	built := st.model
+
+	// Rebuilding the widget tree with the same source reuses the parsed widgets
+	layoutFrames(s, Markdown("# Notes\n\n[a](first)", OnLinkTap(func(url string) { tapped = url })), 1)
+	if st.model != built {
+		t.Error("unchanged source was parsed again")
+	}
+	st.model.onLink("first")
+	if tapped != "first" {
+		t.Errorf("link tap called the stale handler: tapped = %q", tapped)
+	}
+
+	// A new source is parsed again
+	layoutFrames(s, Markdown("# Changed"), 1)
+	if st.model == built {
+		t.Error("changed source was not parsed again")
+	}
+}
@@ -0,0 +1,197 @@
+package ui
+
+import (
+	"image"
+	"slices"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// NavDestination is one destination of a BottomNavigation, NavigationRail or NavigationDrawer
+type NavDestination struct {
+	label string
+	icon  Widget
+}
+
+// NavItem creates a navigation destination; icon may be nil for a label-only destination
+// Usage: NavItem("Inbox", Image(inboxIcon, ImageWidth(24), ImageHeight(24)))
+func NavItem(label string, icon Widget) NavDestination {
+	return NavDestination{label: label, icon: icon}
+}
+
+// navIndicatorDuration is how long the indicator of a newly selected destination takes to grow
+const navIndicatorDuration = 200 * time.Millisecond
+
+// wideLayout is the window width from which a Scaffold shows its NavigationRail
+// instead of its BottomNavigation
+const wideLayout = unit.Dp(600)
+
+// navState is the persistent state of a navigation bar, rail or drawer (internal)
+type navState struct {
+	clicks  []widget.Clickable
+	shown   int       // selected destination whose indicator is growing
+	changed time.Time // when shown was selected
+}
+
+// newNavState creates the state of a navigation widget with nothing shown yet
+func newNavState() *navState {
+	return &navState{shown: -1}
+}
+
+// update selects tapped destinations, reporting the one tapped in this frame or -1
+func (n *navState) update(gtx layout.Context, selected *State[int], count int) int {
+	if len(n.clicks) < count {
+		n.clicks = slices.Grow(n.clicks, count-len(n.clicks))[:count]
+	}
+	tapped := -1
+	for i := range count {
+		if n.clicks[i].Clicked(gtx) {
+			selected.Set(i)
+			tapped = i
+		}
+	}
+	if sel := selected.Get(); sel != n.shown {
+		// The indicator only grows when the selection changes, not on the first frame
+		if n.shown >= 0 {
+			n.changed = gtx.Now
+		}
+		n.shown = sel
+	}
+	return tapped
+}
+
+// indicator returns how far the selected destination's indicator has grown, from 0 to 1
+func (n *navState) indicator(gtx layout.Context) float32 {
+	elapsed := gtx.Now.Sub(n.changed)
+	if elapsed >= navIndicatorDuration {
+		return 1
+	}
+	gtx.Execute(op.InvalidateCmd{})
+	return easeOut(float32(elapsed) / float32(navIndicatorDuration))
+}
+
+// navigationBar lays out destinations side by side along the bottom of the window
+func navigationBar(selected *State[int], items []NavDestination) Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "navbar", "", newNavState)
+		st.update(gtx, selected, len(items))
+		sel, grow := selected.Get(), st.indicator(gtx)
+
+		size := image.Pt(gtx.Constraints.Max.X, gtx.Dp(unit.Dp(80)))
+		paint.FillShape(gtx.Ops, th.Palette.SurfaceContainer, clip.Rect{Max: size}.Op())
+
+		n := max(len(items), 1)
+		for i, item := range items {
+			x0, x1 := i*size.X/n, (i+1)*size.X/n
+			off := op.Offset(image.Pt(x0, 0)).Push(gtx.Ops)
+			igtx := gtx
+			igtx.Constraints = layout.Exact(image.Pt(x1-x0, size.Y))
+			layoutNavItem(igtx, th, &st.clicks[i], item, i == sel, grow, unit.Dp(64))
+			off.Pop()
+		}
+		return layout.Dimensions{Size: size}
+	}
+}
+
+// navigationRail lays out destinations in a column along the side of the window
+func navigationRail(selected *State[int], items []NavDestination) Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "navrail", "", newNavState)
+		st.update(gtx, selected, len(items))
+		sel, grow := selected.Get(), st.indicator(gtx)
+
+		size := image.Pt(gtx.Dp(unit.Dp(80)), gtx.Constraints.Max.Y)
+		paint.FillShape(gtx.Ops, th.Palette.Surface, clip.Rect{Max: size}.Op())
+
+		y := gtx.Dp(unit.Dp(12))
+		for i, item := range items {
+			height := gtx.Dp(unit.Dp(72))
+			if item.icon == nil {
+				height = gtx.Dp(unit.Dp(56))
+			}
+			off := op.Offset(image.Pt(0, y)).Push(gtx.Ops)
+			igtx := gtx
+			igtx.Constraints = layout.Exact(image.Pt(size.X, height))
+			layoutNavItem(igtx, th, &st.clicks[i], item, i == sel, grow, unit.Dp(56))
+			off.Pop()
+			y += height
+		}
+		return layout.Dimensions{Size: size}
+	}
+}
+
+// layoutNavItem draws a destination of a bar or rail centred in gtx.Constraints.Min:
+// its icon on a pill-shaped indicator with the label below, or the label on the indicator
+// if it has no icon. The selected indicator grows out from its centre.
+func layoutNavItem(gtx layout.Context, th *Theme, click *widget.Clickable, item NavDestination, selected bool, grow float32, pillWidth unit.Dp) layout.Dimensions {
+	return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		size := gtx.Constraints.Min
+
+		medium := font.Medium
+		l, _ := (&textModel{content: item.label, style: Caption, weight: &medium, maxLines: 1}).label(th)
+		l.Color = th.Palette.OnSurfaceVariant
+		if selected {
+			l.Color = th.Palette.OnSurface
+			if item.icon == nil {
+				l.Color = th.Palette.OnSecondaryContainer
+			}
+		}
+		lgtx := gtx
+		lgtx.Constraints = layout.Constraints{Max: size}
+		macro := op.Record(gtx.Ops)
+		labelSize := l.Layout(lgtx).Size
+		label := macro.Stop()
+
+		gap := gtx.Dp(unit.Dp(4))
+		pill := image.Pt(gtx.Dp(pillWidth), gtx.Dp(unit.Dp(32)))
+		height := pill.Y + gap + labelSize.Y
+		if item.icon == nil {
+			pill.X = min(labelSize.X+gtx.Dp(unit.Dp(32)), size.X)
+			height = pill.Y
+		}
+		pillRect := image.Rectangle{Max: pill}.Add(image.Pt((size.X-pill.X)/2, (size.Y-height)/2))
+
+		r := pill.Y / 2
+		switch {
+		case selected:
+			w := int(grow * float32(pill.X))
+			rect := pillRect
+			rect.Min.X += (pill.X - w) / 2
+			rect.Max.X = rect.Min.X + w
+			paint.FillShape(gtx.Ops, th.Palette.SecondaryContainer, clip.UniformRRect(rect, min(r, w/2)).Op(gtx.Ops))
+		case click.Hovered() || gtx.Focused(click):
+			paint.FillShape(gtx.Ops, withAlpha(th.Palette.OnSurface, 0x14), clip.UniformRRect(pillRect, r).Op(gtx.Ops))
+		}
+
+		if item.icon == nil {
+			off := op.Offset(pillRect.Min.Add(pill.Sub(labelSize).Div(2))).Push(gtx.Ops)
+			label.Add(gtx.Ops)
+			off.Pop()
+			return layout.Dimensions{Size: size}
+		}
+
+		layoutIcon(gtx, th, item.icon, pillRect)
+		off := op.Offset(image.Pt((size.X-labelSize.X)/2, pillRect.Max.Y+gap)).Push(gtx.Ops)
+		label.Add(gtx.Ops)
+		off.Pop()
+		return layout.Dimensions{Size: size}
+	})
+}
+
+// layoutIcon centres an icon, at most 24dp square, in rect
+func layoutIcon(gtx layout.Context, th *Theme, icon Widget, rect image.Rectangle) {
+	igtx := gtx
+	igtx.Constraints = layout.Constraints{Max: image.Pt(gtx.Dp(unit.Dp(24)), gtx.Dp(unit.Dp(24)))}
+	macro := op.Record(gtx.Ops)
+	dims := icon(igtx, th)
+	call := macro.Stop()
+	defer op.Offset(rect.Min.Add(rect.Size().Sub(dims.Size).Div(2))).Push(gtx.Ops).Pop()
+	call.Add(gtx.Ops)
+}
@@ -0,0 +1,395 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+// ErrUnknownRoute is returned when a path matches none of the navigator's routes
+var ErrUnknownRoute = errors.New("ui: unknown route")
+
+// Params holds the values of a route's :name segments and of the location's query
+// Path segments win over query values of the same name
+type Params map[string]string
+
+// String returns the parameter called name, or "" if there is none
+func (p Params) String(name string) string {
+	return p[name]
+}
+
+// Int returns the parameter called name as an int, or 0 if it is missing or not a number
+func (p Params) Int(name string) int {
+	n, _ := strconv.Atoi(p[name])
+	return n
+}
+
+// NavigatorOption configures a Navigator
+type NavigatorOption func(*Navigator)
+
+// Route registers a page for paths matching pattern. Segments starting with ':' match
+// any single segment and are passed to build as parameters.
+// Usage: Route("/users/:id", func(p Params) Widget { return userPage(p.Int("id")) })
+func Route(pattern string, build func(Params) Widget) NavigatorOption {
+	return func(n *Navigator) {
+		n.routes = append(n.routes, route{segments: splitPath(pattern), build: build})
+	}
+}
+
+// InitialRoute sets the path of the first page (defaults to "/")
+func InitialRoute(path string) NavigatorOption {
+	return func(n *Navigator) { n.initial = path }
+}
+
+// route is a registered page pattern (internal)
+type route struct {
+	segments []string
+	build    func(Params) Widget
+}
+
+// routeEntry is a page on the back stack (internal)
+type routeEntry struct {
+	id   int // unique per push, so each page keeps its own widget state
+	path string
+}
+
+// pageTransition is how long pages take to slide in and out
+const pageTransition = 300 * time.Millisecond
+
+// Navigator moves between pages kept on a back stack.
+// The stack is reactive state, so pushing or popping from anywhere redraws the window.
+// Lay out the current page with View, usually as a Scaffold's Body; Escape and the
+// system back button pop the top page. Pages on the back stack keep their widget state
+// until they are popped.
+type Navigator struct {
+	routes  []route
+	initial string
+	stack   *StateOf[[]routeEntry]
+
+	mu     sync.Mutex
+	nextID int
+}
+
+// navigatorState is the page transition of a Navigator's View in one scope (internal)
+type navigatorState struct {
+	nav       *Navigator // navigator the transition belongs to
+	shown     routeEntry // page on screen
+	from      routeEntry // page animating away
+	start     time.Time
+	animating bool
+	back      bool // the top page is being popped
+}
+
+// NewNavigator creates a navigator showing the initial route
+// Usage: nav := NewNavigator(Route("/", home), Route("/users/:id", user))
+func NewNavigator(opts ...NavigatorOption) *Navigator {
+	n := &Navigator{initial: "/"}
+	for _, opt := range opts {
+		opt(n)
+	}
+	n.stack = NewStateOf([]routeEntry{n.entry(n.initial)}, EqualFunc(slices.Equal[[]routeEntry]))
+	return n
+}
+
+// entry creates a back stack entry for path
+func (n *Navigator) entry(path string) routeEntry {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	e := routeEntry{id: n.nextID, path: path}
+	n.nextID++
+	return e
+}
+
+// match finds the route for a location and extracts its parameters
+// Path segments are URL-unescaped, so "/users/a%20b" passes "a b" as the :name parameter.
+func (n *Navigator) match(location string) (route, Params, bool) {
+	path, query, _ := strings.Cut(location, "?")
+	values, _ := url.ParseQuery(query)
+	segments := splitPath(path)
+	for i, seg := range segments {
+		unescaped, err := url.PathUnescape(seg)
+		if err != nil {
+			return route{}, nil, false
+		}
+		segments[i] = unescaped
+	}
+	for _, r := range n.routes {
+		if len(r.segments) != len(segments) {
+			continue
+		}
+		params := Params{}
+		for name := range values {
+			params[name] = values.Get(name)
+		}
+		matched := true
+		for i, seg := range r.segments {
+			if name, ok := strings.CutPrefix(seg, ":"); ok {
+				params[name] = segments[i]
+			} else if seg != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return r, params, true
+		}
+	}
+	return route{}, nil, false
+}
+
+// check returns an error if a location matches no route
+func (n *Navigator) check(location string) error {
+	if _, _, ok := n.match(location); !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownRoute, location)
+	}
+	return nil
+}
+
+// Push shows the page for path on top of the current one
+// The path may carry a query, whose values are passed to the page as parameters
+// Usage: nav.Push("/settings/profile?tab=2")
+func (n *Navigator) Push(path string) error {
+	if err := n.check(path); err != nil {
+		return err
+	}
+	e := n.entry(path)
+	n.stack.Update(func(stack []routeEntry) []routeEntry {
+		return append(slices.Clip(stack), e)
+	})
+	return nil
+}
+
+// Replace swaps the current page for the page for path
+func (n *Navigator) Replace(path string) error {
+	if err := n.check(path); err != nil {
+		return err
+	}
+	e := n.entry(path)
+	n.stack.Update(func(stack []routeEntry) []routeEntry {
+		return append(slices.Clone(stack[:len(stack)-1]), e)
+	})
+	return nil
+}
+
+// Pop returns to the previous page; it reports false and does nothing on the first page
+func (n *Navigator) Pop() bool {
+	popped := false
+	n.stack.Update(func(stack []routeEntry) []routeEntry {
+		if len(stack) < 2 {
+			return stack
+		}
+		popped = true
+		return stack[:len(stack)-1]
+	})
+	return popped
+}
+
+// CanPop reports whether there is a page to go back to
+func (n *Navigator) CanPop() bool {
+	return len(n.stack.Get()) > 1
+}
+
+// Open replaces the whole stack with the page for location and the pages of its parent paths,
+// the way a browser opens a deep link: "/settings/profile?tab=2" stacks the initial route,
+// "/settings" (if it is a route) and "/settings/profile?tab=2", so Pop walks up the hierarchy.
+// Use it to restore the location saved from Current, or to open a page named on the
+// command line.
+func (n *Navigator) Open(location string) error {
+	if err := n.check(location); err != nil {
+		return err
+	}
+	n.stack.Set(n.deepLink(location))
+	return nil
+}
+
+// deepLink returns the stack Open builds for location
+func (n *Navigator) deepLink(location string) []routeEntry {
+	var stack []routeEntry
+	if location != n.initial && n.check(n.initial) == nil {
+		stack = append(stack, n.entry(n.initial))
+	}
+	path, _, _ := strings.Cut(location, "?")
+	segments := splitPath(path)
+	for i := range segments[:max(len(segments)-1, 0)] {
+		parent := "/" + strings.Join(segments[:i+1], "/")
+		if parent != n.initial && n.check(parent) == nil {
+			stack = append(stack, n.entry(parent))
+		}
+	}
+	return append(stack, n.entry(location))
+}
+
+// MarshalText returns the current location if opening it rebuilds the back stack (see Open),
+// else the locations on the stack, each on its own line with the current page last,
+// so a Navigator can be saved as text
+func (n *Navigator) MarshalText() ([]byte, error) {
+	stack := n.Stack()
+	current := stack[len(stack)-1]
+	opened := n.deepLink(current)
+	if slices.EqualFunc(stack, opened, func(location string, e routeEntry) bool { return location == e.path }) {
+		return []byte(current), nil
+	}
+
+	var b strings.Builder
+	for _, location := range stack {
+		b.WriteString(location)
+		b.WriteByte('\n')
+	}
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText restores the back stack saved by MarshalText exactly. Text without a line
+// break is a single location, which is opened with its parent pages (see Open), so with
+// flag.TextVar(nav, "page", nav, "page to open") the page can be picked on the command line.
+func (n *Navigator) UnmarshalText(text []byte) error {
+	saved := string(text)
+	if strings.TrimSpace(saved) == "" {
+		return errors.New("ui: no location to navigate to")
+	}
+	if !strings.Contains(saved, "\n") {
+		return n.Open(strings.TrimSpace(saved))
+	}
+
+	locations := strings.Split(strings.TrimSpace(saved), "\n")
+	stack := make([]routeEntry, len(locations))
+	for i, location := range locations {
+		if err := n.check(location); err != nil {
+			return err
+		}
+		stack[i] = n.entry(location)
+	}
+	n.stack.Set(stack)
+	return nil
+}
+
+// Current returns the location of the page on top of the stack
+func (n *Navigator) Current() string {
+	stack := n.stack.Get()
+	return stack[len(stack)-1].path
+}
+
+// Stack returns the locations on the back stack, the current page last
+func (n *Navigator) Stack() []string {
+	stack := n.stack.Get()
+	paths := make([]string, len(stack))
+	for i, e := range stack {
+		paths[i] = e.path
+	}
+	return paths
+}
+
+// Watch registers fn to be called after every navigation (implements Observable)
+func (n *Navigator) Watch(fn func()) (unwatch func()) {
+	return n.stack.Watch(fn)
+}
+
+// View lays out the current page, sliding pages in when pushed and out when popped
+// Each window showing the View animates its own transitions.
+// Usage: Scaffold(AppBar(TitleBar("Mail")), Body(nav.View()))
+func (n *Navigator) View() Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "navigator", "", func() *navigatorState { return new(navigatorState) })
+		pos := scopedContainer(gtx, "navigator")
+		stack := n.stack.Get()
+		top := stack[len(stack)-1]
+
+		// Pages below the top are not laid out, but keep their state for when they return
+		for _, e := range stack[:len(stack)-1] {
+			scopedRetainChild(gtx, pos, e.id)
+		}
+
+		if st.nav != n {
+			*st = navigatorState{nav: n, shown: top}
+		}
+		if top.id != st.shown.id {
+			// Pages pushed later have higher ids, so a lower id means we went back
+			st.from, st.back, st.start, st.animating = st.shown, top.id < st.shown.id, gtx.Now, true
+			st.shown = top
+		}
+
+		progress := float32(1)
+		if st.animating {
+			if elapsed := gtx.Now.Sub(st.start); elapsed < pageTransition {
+				progress = easeOut(float32(elapsed) / float32(pageTransition))
+				gtx.Execute(op.InvalidateCmd{})
+			} else {
+				st.animating = false
+			}
+		}
+
+		size := gtx.Constraints.Max
+		defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+
+		var dims layout.Dimensions
+		switch {
+		case !st.animating:
+			dims = n.layoutPage(gtx, th, pos, top, 0, false)
+		case st.back:
+			// The popped page slides away to the right, uncovering the one below
+			dims = n.layoutPage(gtx, th, pos, top, 0, true)
+			n.layoutPage(gtx, th, pos, st.from, int(progress*float32(size.X)), true)
+		default:
+			n.layoutPage(gtx, th, pos, st.from, 0, true)
+			dims = n.layoutPage(gtx, th, pos, top, int((1-progress)*float32(size.X)), true)
+		}
+
+		n.handleBack(gtx)
+		return dims
+	}
+}
+
+// layoutPage builds and lays out the page of e, shifted right by x pixels
+// Opaque pages get a surface background, so they hide the page beneath while sliding
+func (n *Navigator) layoutPage(gtx layout.Context, th *Theme, pos string, e routeEntry, x int, opaque bool) layout.Dimensions {
+	r, params, ok := n.match(e.path)
+	if !ok {
+		return layout.Dimensions{}
+	}
+	defer op.Offset(image.Pt(x, 0)).Push(gtx.Ops).Pop()
+	if opaque {
+		paint.FillShape(gtx.Ops, th.Palette.Surface, clip.Rect{Max: gtx.Constraints.Max}.Op())
+	}
+	return scopedChild(gtx, pos, e.id, func() layout.Dimensions {
+		return r.build(params)(gtx, th)
+	})
+}
+
+// handleBack pops the top page on Escape or the system back button.
+// It runs after the page so menus inside it close first, and leaves the keys to open dialogs.
+func (n *Navigator) handleBack(gtx layout.Context) {
+	s := currentScope(gtx)
+	s.mu.Lock()
+	overlays := len(s.overlays)
+	s.mu.Unlock()
+	if overlays > 0 || !n.CanPop() {
+		return
+	}
+
+	for {
+		ev, ok := gtx.Event(key.Filter{Name: key.NameEscape}, key.Filter{Name: key.NameBack})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(key.Event); ok && e.State == key.Press {
+			n.Pop()
+		}
+	}
+}
+
+// splitPath splits a path into its non-empty segments
+func splitPath(path string) []string {
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+}
@@ -0,0 +1,49 @@
+//go:build js
+
+package ui
+
+import (
+	"log"
+	"strings"
+	"syscall/js"
+)
+
+// BindLocationHash keeps the browser's location.hash in step with the navigator.
+// The page named by the hash (as in "#/users/42") opens on launch, navigating updates
+// the hash, and the browser's back button or an edited hash navigates in turn.
+func (n *Navigator) BindLocationHash() {
+	location := js.Global().Get("location")
+	hash := func() string {
+		return strings.TrimPrefix(location.Get("hash").String(), "#")
+	}
+	// open shows the page named by the hash, or puts the current page back for an unknown one
+	open := func(h string) {
+		if err := n.Open(h); err != nil {
+			log.Print(err)
+			location.Set("hash", "#"+n.Current())
+		}
+	}
+
+	if h := hash(); h != "" {
+		open(h)
+	}
+	n.Watch(func() {
+		if current := n.Current(); hash() != current {
+			location.Set("hash", "#"+current)
+		}
+	})
+
+	onHashChange := js.FuncOf(func(js.Value, []js.Value) any {
+		h := hash()
+		if h == "" || h == n.Current() {
+			return nil
+		}
+		if stack := n.Stack(); len(stack) > 1 && stack[len(stack)-2] == h {
+			n.Pop()
+		} else {
+			open(h)
+		}
+		return nil
+	})
+	js.Global().Call("addEventListener", "hashchange", onHashChange)
+}
@@ -0,0 +1,7 @@
+//go:build !js
+
+package ui
+
+// BindLocationHash keeps the browser's location.hash in step with the navigator.
+// It does nothing outside WebAssembly builds running in a browser.
+func (n *Navigator) BindLocationHash() {}
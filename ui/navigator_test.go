@@ -0,0 +1,212 @@
+package ui
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"gioui.org/layout"
+	"gioui.org/widget"
+)
+
+func TestNavigatorKeepsBackStackState(t *testing.T) {
+	s := NewScope(EvictAfter(5))
+	defer s.Release()
+
+	var home *widget.Clickable
+	nav := NewNavigator(
+		Route("/", func(Params) Widget {
+			return func(gtx layout.Context, th *Theme) layout.Dimensions {
+				home = getClickable(gtx, "")
+				return layout.Dimensions{}
+			}
+		}),
+		Route("/next", func(Params) Widget { return SizedBox() }),
+	)
+	w := nav.View()
+
+	layoutFrames(s, w, 1)
+	before := home
+	if err := nav.Push("/next"); err != nil {
+		t.Fatal(err)
+	}
+	layoutFrames(s, w, 40)
+	nav.Pop()
+	layoutFrames(s, w, 1)
+	if home != before {
+		t.Error("page on the back stack lost its state")
+	}
+}
+
+func TestNavigatorAnimatesEachWindow(t *testing.T) {
+	s1, s2 := NewScope(), NewScope()
+	defer s1.Release()
+	defer s2.Release()
+
+	var homeShown bool
+	nav := NewNavigator(
+		Route("/", func(Params) Widget {
+			return func(gtx layout.Context, th *Theme) layout.Dimensions {
+				homeShown = true
+				return layout.Dimensions{}
+			}
+		}),
+		Route("/next", func(Params) Widget { return SizedBox() }),
+	)
+	w := nav.View()
+
+	layoutFrames(s1, w, 1)
+	layoutFrames(s2, w, 1)
+	if err := nav.Push("/next"); err != nil {
+		t.Fatal(err)
+	}
+	layoutFrames(s1, w, 1)
+
+	homeShown = false
+	layoutFrames(s2, w, 1)
+	if !homeShown {
+		t.Error("second window skipped the transition started by the first")
+	}
+}
+
+// testNavigator creates a navigator with nested routes whose pages are empty
+func testNavigator() *Navigator {
+	page := func(Params) Widget { return SizedBox() }
+	return NewNavigator(
+		Route("/", page),
+		Route("/users", page),
+		Route("/users/:id", page),
+		Route("/users/:id/posts/:post", page),
+		Route("/settings/profile", page),
+	)
+}
+
+func TestNavigatorMatch(t *testing.T) {
+	tests := []struct {
+		location string
+		ok       bool
+		params   Params
+	}{
+		{"/", true, Params{}},
+		{"", true, Params{}},
+		{"/users", true, Params{}},
+		{"/users/", true, Params{}},
+		{"/users/42", true, Params{"id": "42"}},
+		{"/users/a%20b", true, Params{"id": "a b"}},
+		{"/users/a%2Fb", true, Params{"id": "a/b"}},
+		{"/users/%zz", false, nil},
+		{"/users/42/posts/7", true, Params{"id": "42", "post": "7"}},
+		{"/users/42?tab=2&q=x%20y", true, Params{"id": "42", "tab": "2", "q": "x y"}},
+		{"/users/42?id=1", true, Params{"id": "42"}},
+		{"/settings/profile", true, Params{}},
+		{"/settings", false, nil},
+		{"/users/42/posts", false, nil},
+	}
+	n := testNavigator()
+	for _, tt := range tests {
+		_, params, ok := n.match(tt.location)
+		if ok != tt.ok || !maps.Equal(params, tt.params) {
+			t.Errorf("match(%q) = %v, %v, want %v, %v", tt.location, params, ok, tt.params, tt.ok)
+		}
+	}
+}
+
+func TestNavigatorOpen(t *testing.T) {
+	tests := []struct {
+		location string
+		stack    []string
+		err      error
+	}{
+		{"/", []string{"/"}, nil},
+		{"/users", []string{"/", "/users"}, nil},
+		{"/users/42?tab=2", []string{"/", "/users", "/users/42?tab=2"}, nil},
+		{"/users/42/posts/7", []string{"/", "/users", "/users/42", "/users/42/posts/7"}, nil},
+		{"/settings/profile", []string{"/", "/settings/profile"}, nil},
+		{"/missing", []string{"/"}, ErrUnknownRoute},
+	}
+	for _, tt := range tests {
+		n := testNavigator()
+		err := n.Open(tt.location)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Open(%q) error = %v, want %v", tt.location, err, tt.err)
+		}
+		if got := n.Stack(); !slices.Equal(got, tt.stack) {
+			t.Errorf("Open(%q) stack = %q, want %q", tt.location, got, tt.stack)
+		}
+	}
+}
+
+func TestNavigatorUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text  string
+		stack []string
+		err   error
+	}{
+		{"/users/42", []string{"/", "/users", "/users/42"}, nil},
+		{"/settings/profile\n/users/42", []string{"/settings/profile", "/users/42"}, nil},
+		{"/\n/users/1\n/users/2\n", []string{"/", "/users/1", "/users/2"}, nil},
+		{"/\n/missing", []string{"/"}, ErrUnknownRoute},
+		{"/users/42\n", []string{"/users/42"}, nil},
+	}
+	for _, tt := range tests {
+		n := testNavigator()
+		err := n.UnmarshalText([]byte(tt.text))
+		if !errors.Is(err, tt.err) {
+			t.Errorf("UnmarshalText(%q) error = %v, want %v", tt.text, err, tt.err)
+		}
+		if got := n.Stack(); !slices.Equal(got, tt.stack) {
+			t.Errorf("UnmarshalText(%q) stack = %q, want %q", tt.text, got, tt.stack)
+		}
+	}
+}
+
+func TestNavigatorUnmarshalEmptyText(t *testing.T) {
+	for _, text := range []string{"", " \n"} {
+		n := testNavigator()
+		if err := n.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("UnmarshalText(%q) succeeded with stack %q", text, n.Stack())
+		}
+	}
+}
+
+func TestNavigatorTextRoundTrip(t *testing.T) {
+	n := testNavigator()
+	for _, path := range []string{"/settings/profile", "/users/a%20b?tab=2", "/users/a%20b/posts/1"} {
+		if err := n.Push(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	roundTrip := func() {
+		t.Helper()
+		text, err := n.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		restored := testNavigator()
+		if err := restored.UnmarshalText(text); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := restored.Stack(), n.Stack(); !slices.Equal(got, want) {
+			t.Errorf("restored stack = %q, want %q", got, want)
+		}
+	}
+	roundTrip()
+
+	// A single page is restored as it was, not opened with its parents
+	n = testNavigator()
+	if err := n.Replace("/users/42"); err != nil {
+		t.Fatal(err)
+	}
+	roundTrip()
+
+	// A stack Open rebuilds is saved as its location, so it reads well as a flag default
+	n = testNavigator()
+	if err := n.Open("/users/42"); err != nil {
+		t.Fatal(err)
+	}
+	if text, _ := n.MarshalText(); string(text) != "/users/42" {
+		t.Errorf("MarshalText after Open = %q, want %q", text, "/users/42")
+	}
+	roundTrip()
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// popup is the persistent state of a menu or dialog drawn above the rest of the window (internal)
+type popup struct {
+	open    bool
+	scrim   color.NRGBA // tint drawn over the window behind the popup
+	barrier bool        // tag receiving taps outside the popup
+	content bool        // tag swallowing taps on the popup's background
+}
+
+// update closes the popup when the user tapped outside it or pressed Escape
+// Call it every frame, after anything that may open the popup, so the filters stay registered
+func (p *popup) update(gtx layout.Context) {
+	if !p.open {
+		return
+	}
+	for {
+		ev, ok := gtx.Event(
+			pointer.Filter{Target: &p.barrier, Kinds: pointer.Press},
+			key.Filter{Name: key.NameEscape},
+		)
+		if !ok {
+			break
+		}
+		switch ev := ev.(type) {
+		case pointer.Event:
+			p.open = false
+		case key.Event:
+			if ev.State == key.Press {
+				p.open = false
+			}
+		}
+	}
+}
+
+// layout draws content at offset, above every widget laid out in this frame.
+// A transparent barrier covering the window catches taps outside the content;
+// with pass set those taps also reach the widgets underneath.
+// gtx.Constraints bound the content.
+func (p *popup) layout(gtx layout.Context, th *Theme, offset image.Point, pass bool, content Widget) {
+	if !p.open {
+		return
+	}
+
+	macro := op.Record(gtx.Ops)
+	barrier := clip.Rect{Min: image.Pt(-1e6, -1e6), Max: image.Pt(1e6, 1e6)}.Push(gtx.Ops)
+	if p.scrim.A > 0 {
+		paint.ColorOp{Color: p.scrim}.Add(gtx.Ops)
+		paint.PaintOp{}.Add(gtx.Ops)
+	}
+	if pass {
+		passOp := pointer.PassOp{}.Push(gtx.Ops)
+		event.Op(gtx.Ops, &p.barrier)
+		passOp.Pop()
+	} else {
+		event.Op(gtx.Ops, &p.barrier)
+	}
+	barrier.Pop()
+
+	off := op.Offset(offset).Push(gtx.Ops)
+	inner := op.Record(gtx.Ops)
+	dims := content(gtx, th)
+	call := inner.Stop()
+	area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+	event.Op(gtx.Ops, &p.content)
+	area.Pop()
+	call.Add(gtx.Ops)
+	off.Pop()
+
+	op.Defer(gtx.Ops, macro.Stop())
+}
+
+// layoutSurface draws child on a rounded, raised background of color bg
+// Used by menus and other content floating above the window
+func layoutSurface(gtx layout.Context, th *Theme, bg color.NRGBA, radius, elevation unit.Dp, child layout.Widget) layout.Dimensions {
+	macro := op.Record(gtx.Ops)
+	dims := child(gtx)
+	call := macro.Stop()
+
+	r := gtx.Dp(radius)
+	rect := image.Rectangle{Max: dims.Size}
+	if e := gtx.Dp(elevation); e > 0 {
+		shadow := withAlpha(th.Palette.Shadow, 0x30)
+		paint.FillShape(gtx.Ops, shadow, clip.UniformRRect(rect.Add(image.Pt(0, e/2)).Inset(-e/2), r+e/2).Op(gtx.Ops))
+	}
+	paint.FillShape(gtx.Ops, bg, clip.UniformRRect(rect, r).Op(gtx.Ops))
+
+	clipped := clip.UniformRRect(rect, r).Push(gtx.Ops)
+	call.Add(gtx.Ops)
+	clipped.Pop()
+	return dims
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// RadioItem is one choice of a RadioGroup
+type RadioItem struct {
+	value string
+	label string
+}
+
+// Radio creates a RadioGroup choice; value is stored in the group's state when it is selected
+func Radio(value, label string) RadioItem {
+	return RadioItem{value: value, label: label}
+}
+
+// getEnum returns a persistent single-choice group for the given ID in the current scope
+func getEnum(gtx layout.Context, id string) *widget.Enum {
+	return scopedState(gtx, "radio", id, func() *widget.Enum { return new(widget.Enum) })
+}
+
+// RadioGroup creates a vertical list of radio buttons bound to the selected value
+// Usage: RadioGroup(size, []RadioItem{Radio("s", "Small"), Radio("m", "Medium"), Radio("l", "Large")}, ToggleDisabled())
+func RadioGroup(selected *State[string], items []RadioItem, opts ...ToggleOption) Widget {
+	t := newToggleModel(opts)
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		enum := getEnum(gtx, t.id)
+		enum.Value = selected.Get()
+
+		if t.disabled {
+			gtx = gtx.Disabled()
+		}
+		if enum.Update(gtx) {
+			selected.Set(enum.Value)
+		}
+		hovered, hovering := enum.Hovered()
+
+		children := make([]layout.FlexChild, len(items))
+		for i, item := range items {
+			children[i] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return enum.Layout(gtx, item.value, func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							hover := hovering && hovered == item.value && !t.disabled
+							return layoutToggleTarget(gtx, th, hover, func(gtx layout.Context) layout.Dimensions {
+								return drawRadio(gtx, th, enum.Value == item.value, t.disabled)
+							})
+						}),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							return layoutToggleLabel(gtx, th, item.label, t.disabled)
+						}),
+					)
+				})
+			})
+		}
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	}
+}
+
+// drawRadio paints a 20dp Material 3 radio button
+func drawRadio(gtx layout.Context, th *Theme, selected, disabled bool) layout.Dimensions {
+	size := gtx.Dp(unit.Dp(20))
+	stroke := gtx.Dp(unit.Dp(2))
+	ring := image.Rectangle{Max: image.Pt(size, size)}
+
+	c := th.Palette.OnSurfaceVariant
+	if selected {
+		c = th.Palette.Primary
+	}
+	if disabled {
+		c = withAlpha(th.Palette.OnSurface, disabledAlpha)
+	}
+
+	inner := ring.Inset(stroke / 2)
+	paint.FillShape(gtx.Ops, c, clip.Stroke{Path: clip.Ellipse(inner).Path(gtx.Ops), Width: float32(stroke)}.Op())
+	if selected {
+		paint.FillShape(gtx.Ops, c, clip.Ellipse(ring.Inset(gtx.Dp(unit.Dp(5)))).Op(gtx.Ops))
+	}
+	return layout.Dimensions{Size: ring.Max}
+}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"gioui.org/layout"
+	"gioui.org/x/richtext"
+)
+
+// Span is a run of styled text inside a RichText paragraph
+type Span struct {
+	content string
+	opts    []SpanOption
+	onTap   func()
+	link    bool
+}
+
+// TextSpan creates a span styled with the character options of Text (size, color, weight, font...)
+// A Style's line height and letter spacing are ignored, as they apply to whole paragraphs.
+// Usage: TextSpan("important", Bold(), TextColor(Red))
+func TextSpan(content string, opts ...SpanOption) Span {
+	return Span{content: content, opts: opts}
+}
+
+// LinkSpan creates a span drawn in the theme's primary color that calls onTap when tapped
+// It takes the same options as TextSpan.
+// Usage: LinkSpan("Terms", func() { openURL(termsURL) })
+func LinkSpan(content string, onTap func(), opts ...SpanOption) Span {
+	return Span{content: content, opts: opts, onTap: onTap, link: true}
+}
+
+// OnTap returns a copy of the span that calls fn when tapped
+func (s Span) OnTap(fn func()) Span {
+	s.onTap = fn
+	return s
+}
+
+// RichText creates a paragraph mixing differently styled and tappable spans
+// Usage: RichText(TextSpan("By continuing you accept the "), LinkSpan("Terms", showTerms), TextSpan("."))
+func RichText(spans ...Span) Widget {
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		state := scopedState(gtx, "richtext", "", func() *richtext.InteractiveText { return new(richtext.InteractiveText) })
+
+		// Handle taps before layout, which discards unprocessed events
+		for {
+			span, event, ok := state.Update(gtx)
+			if !ok {
+				break
+			}
+			if event.Type != richtext.Click {
+				continue
+			}
+			if i, ok := span.Get("span").(int); ok && i < len(spans) && spans[i].onTap != nil {
+				spans[i].onTap()
+			}
+		}
+
+		styles := make([]richtext.SpanStyle, len(spans))
+		for i, s := range spans {
+			t := &textModel{content: s.content, style: BodyText}
+			if s.link {
+				c := th.Palette.Primary
+				t.color = &c
+			}
+			for _, opt := range s.opts {
+				opt.applySpan(t)
+			}
+			label, _ := t.label(th)
+
+			styles[i] = richtext.SpanStyle{
+				Font:        label.Font,
+				Size:        label.TextSize,
+				Color:       label.Color,
+				Content:     s.content,
+				Interactive: s.onTap != nil,
+			}
+			styles[i].Set("span", i)
+		}
+
+		return richtext.Text(state, th.Shaper, styles...).Layout(gtx)
+	}
+}
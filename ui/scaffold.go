@@ -1,7 +1,12 @@
 package ui
 
 import (
+	"image"
+
 	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
 	"gioui.org/unit"
 	"gioui.org/widget"
 	"gioui.org/widget/material"
@@ -15,24 +20,62 @@ func AppBar(bar Widget) ScaffoldOption {
 	return func(s *scaffoldModel) { s.appBar = bar }
 }
 
+// Bottom sets a widget shown under the app bar, such as a TabBar
+func Bottom(bottom Widget) ScaffoldOption {
+	return func(s *scaffoldModel) { s.bottom = bottom }
+}
+
 // Body sets the main content for the Scaffold
 func Body(body Widget) ScaffoldOption {
 	return func(s *scaffoldModel) { s.body = body }
 }
 
-// FAB sets the floating action button for the Scaffold
+// FAB sets the floating action button for the Scaffold, usually a FloatingActionButton or ExtendedFAB
+// It floats over the body's bottom end corner unless FABPosition says otherwise.
 func FAB(fab Widget) ScaffoldOption {
 	return func(s *scaffoldModel) { s.fab = fab }
 }
 
+// FABPosition sets where the Scaffold places its FAB (defaults to FABEndFloat)
+// Usage: Scaffold(FAB(FloatingActionButton(nil)), FABPosition(FABCenterDocked), BottomNavigation(page, items...))
+func FABPosition(location FABLocation) ScaffoldOption {
+	return func(s *scaffoldModel) { s.fabLocation = location }
+}
+
+// BottomNavigation shows destinations in a bar along the bottom of the Scaffold, bound to the selected index
+// With a NavigationRail as well, the bar is only shown on windows narrower than 600dp.
+// Usage: BottomNavigation(page, NavItem("Home", homeIcon), NavItem("Search", searchIcon))
+func BottomNavigation(selected *State[int], items ...NavDestination) ScaffoldOption {
+	return func(s *scaffoldModel) { s.navBar = navigationBar(selected, items) }
+}
+
+// NavigationRail shows destinations in a column along the side of the Scaffold, bound to the selected index
+// With a BottomNavigation as well, the rail replaces the bar on windows at least 600dp wide.
+func NavigationRail(selected *State[int], items ...NavDestination) ScaffoldOption {
+	return func(s *scaffoldModel) { s.rail = navigationRail(selected, items) }
+}
+
+// Drawer sets a panel that slides in over the Scaffold from the side, usually a NavigationDrawer
+// TitleBar shows a hamburger button that opens it; tapping outside or pressing Escape closes it.
+func Drawer(content Widget) ScaffoldOption {
+	return func(s *scaffoldModel) { s.drawer = content }
+}
+
 // scaffoldModel holds the configuration (internal)
 type scaffoldModel struct {
 	appBar Widget
+	bottom Widget
 	body   Widget
 	fab    Widget
+	navBar Widget
+	rail   Widget
+	drawer Widget
+
+	fabLocation FABLocation
 }
 
-// Scaffold creates a top-level app layout with optional AppBar, Body, and FAB
+// Scaffold creates a top-level app layout with optional AppBar, Bottom, Body, and FAB,
+// and navigation through a BottomNavigation, NavigationRail or Drawer
 func Scaffold(opts ...ScaffoldOption) Widget {
 	s := &scaffoldModel{}
 	for _, opt := range opts {
@@ -40,40 +83,184 @@ func Scaffold(opts ...ScaffoldOption) Widget {
 	}
 
 	return func(gtx layout.Context, th *Theme) layout.Dimensions {
-		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		// Each slot gets its own key space so widget state survives slots coming and going
+		pos := scopedContainer(gtx, "scaffold")
+
+		// Paint the surface behind the whole scaffold
+		paint.FillShape(gtx.Ops, th.Palette.Surface, clip.Rect{Max: gtx.Constraints.Max}.Op())
+
+		// Tell TitleBar about the drawer, which goes first so Escape closes it before the body sees it
+		sc := currentScope(gtx)
+		var drawer *drawerState
+		if s.drawer != nil {
+			drawer = scopedState(gtx, "drawer", "", func() *drawerState { return new(drawerState) })
+		}
+		sc.mu.Lock()
+		prev := sc.drawer
+		sc.drawer = drawer
+		if prev == nil && drawer != nil {
+			// Remember the outermost drawer for OpenDrawer between frames
+			sc.windowDrawer, sc.windowDrawerSet = drawer, sc.frame
+		}
+		sc.mu.Unlock()
+		defer func() {
+			sc.mu.Lock()
+			sc.drawer = prev
+			sc.mu.Unlock()
+		}()
+		if drawer != nil {
+			scopedChild(gtx, pos, 6, func() layout.Dimensions {
+				drawer.layout(gtx, th, sc, s.drawer)
+				return layout.Dimensions{}
+			})
+		}
+
+		// Wide windows get the rail, narrow ones the bottom bar
+		navBar, rail := s.navBar, s.rail
+		if navBar != nil && rail != nil {
+			if gtx.Constraints.Max.X >= gtx.Dp(wideLayout) {
+				navBar = nil
+			} else {
+				rail = nil
+			}
+		}
+
+		// The body fills the space between the top and bottom bars, beside the rail
+		var area image.Rectangle
+		body := func(gtx layout.Context) layout.Dimensions {
+			area.Max = area.Min.Add(gtx.Constraints.Max)
+			if s.body != nil {
+				return scopedChild(gtx, pos, 1, func() layout.Dimensions {
+					return s.body(gtx, th)
+				})
+			}
+			return layout.Dimensions{}
+		}
+
+		navHeight := 0
+		dims := layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 				if s.appBar != nil {
-					return s.appBar(gtx, th)
+					bar := scopedChild(gtx, pos, 0, func() layout.Dimensions {
+						return s.appBar(gtx, th)
+					})
+					area.Min.Y += bar.Size.Y
+					return bar
 				}
 				return layout.Dimensions{}
 			}),
-			layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
-				if s.body != nil {
-					return layout.Inset{Left: 0, Right: 0, Top: 0, Bottom: unit.Dp(80)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-						return s.body(gtx, th)
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if s.bottom != nil {
+					bottom := scopedChild(gtx, pos, 3, func() layout.Dimensions {
+						return s.bottom(gtx, th)
 					})
+					area.Min.Y += bottom.Size.Y
+					return bottom
 				}
 				return layout.Dimensions{}
 			}),
-			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-				if s.fab != nil {
-					return layout.SE.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-						return layout.Inset{Right: unit.Dp(16), Bottom: unit.Dp(16)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-							return s.fab(gtx, th)
+			layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+				if rail == nil {
+					return body(gtx)
+				}
+				return layout.Flex{}.Layout(gtx,
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						r := scopedChild(gtx, pos, 5, func() layout.Dimensions {
+							return rail(gtx, th)
 						})
-					})
+						area.Min.X += r.Size.X
+						return r
+					}),
+					layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+						gtx.Constraints.Min.X = 0
+						return body(gtx)
+					}),
+				)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if navBar == nil {
+					return layout.Dimensions{}
 				}
-				return layout.Dimensions{}
+				nav := scopedChild(gtx, pos, 4, func() layout.Dimensions {
+					return navBar(gtx, th)
+				})
+				navHeight = nav.Size.Y
+				return nav
 			}),
 		)
+
+		// Snackbars float above the FAB and the bottom bar
+		above := dims.Size.Y - area.Max.Y
+		if s.fab != nil {
+			above = dims.Size.Y - s.layoutFAB(gtx, th, pos, area, navHeight > 0)
+		}
+		gtx.Constraints.Max = dims.Size
+		layoutSnackbar(gtx, th, above)
+		return dims
+	}
+}
+
+// layoutFAB draws the FAB over the body area according to its location and returns its top edge
+// Docking needs a bottom bar to sit on; without one a docked FAB floats in the centre.
+func (s *scaffoldModel) layoutFAB(gtx layout.Context, th *Theme, pos string, area image.Rectangle, bottomBar bool) int {
+	gtx.Constraints = layout.Constraints{Max: area.Size()}
+	macro := op.Record(gtx.Ops)
+	dims := scopedChild(gtx, pos, 2, func() layout.Dimensions {
+		return s.fab(gtx, th)
+	})
+	call := macro.Stop()
+
+	at := fabOffset(s.fabLocation, area, dims.Size, gtx.Dp(unit.Dp(16)), bottomBar)
+	defer op.Offset(at).Push(gtx.Ops).Pop()
+	call.Add(gtx.Ops)
+	return at.Y
+}
+
+// fabOffset returns the top-left corner of a FAB of the given size placed at location in area,
+// margin from its edges; FABCenterDocked straddles the top of the bottom bar if there is one
+func fabOffset(location FABLocation, area image.Rectangle, size image.Point, margin int, bottomBar bool) image.Point {
+	centre := area.Min.X + (area.Dx()-size.X)/2
+	at := image.Pt(area.Max.X-margin-size.X, area.Max.Y-margin-size.Y)
+	switch {
+	case location == FABCenterDocked && bottomBar:
+		at = image.Pt(centre, area.Max.Y-size.Y/2)
+	case location != FABEndFloat:
+		at.X = centre
 	}
+	return at
 }
 
 // TitleBar creates a simple title bar widget
+// In a Scaffold with a Drawer it starts with a hamburger button that opens the drawer.
 func TitleBar(title string) Widget {
 	return func(gtx layout.Context, th *Theme) layout.Dimensions {
-		return material.List(th.Theme, new(widget.List)).Layout(gtx, 1, func(gtx layout.Context, _ int) layout.Dimensions {
-			return layout.UniformInset(unit.Dp(16)).Layout(gtx, material.H6(th.Theme, title).Layout)
-		})
+		titleWidget := func(gtx layout.Context) layout.Dimensions {
+			return material.List(th.Theme, new(widget.List)).Layout(gtx, 1, func(gtx layout.Context, _ int) layout.Dimensions {
+				return layout.UniformInset(unit.Dp(16)).Layout(gtx, material.H6(th.Theme, title).Layout)
+			})
+		}
+
+		s := currentScope(gtx)
+		s.mu.Lock()
+		drawer := s.drawer
+		s.mu.Unlock()
+		if drawer == nil {
+			return titleWidget(gtx)
+		}
+
+		menu := scopedState(gtx, "titlebar", "", func() *widget.Clickable { return new(widget.Clickable) })
+		if menu.Clicked(gtx) {
+			s.mu.Lock()
+			drawer.setOpen(!drawer.shown, gtx.Now)
+			s.mu.Unlock()
+		}
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layout.Inset{Left: unit.Dp(4)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return layoutDrawerButton(gtx, th, menu)
+				})
+			}),
+			layout.Flexed(1, titleWidget),
+		)
 	}
 }
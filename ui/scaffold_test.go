@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"image"
+	"testing"
+)
+
+func TestFABPlacement(t *testing.T) {
+	size := image.Pt(56, 56)
+	window := image.Rect(0, 0, 400, 300)
+	withRail := image.Rect(80, 0, 400, 300)
+
+	tests := []struct {
+		name      string
+		location  FABLocation
+		area      image.Rectangle
+		bottomBar bool
+		want      image.Point
+	}{
+		{"end float", FABEndFloat, window, false, image.Pt(328, 228)},
+		{"end float above a bottom bar", FABEndFloat, window, true, image.Pt(328, 228)},
+		{"centre float", FABCenterFloat, window, false, image.Pt(172, 228)},
+		{"centre docked on the bottom bar", FABCenterDocked, window, true, image.Pt(172, 272)},
+		{"centre docked without a bottom bar floats", FABCenterDocked, window, false, image.Pt(172, 228)},
+		{"centre float beside a rail", FABCenterFloat, withRail, false, image.Pt(212, 228)},
+		{"end float beside a rail", FABEndFloat, withRail, false, image.Pt(328, 228)},
+	}
+	for _, tt := range tests {
+		if got := fabOffset(tt.location, tt.area, size, 16, tt.bottomBar); got != tt.want {
+			t.Errorf("%s: FAB at %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
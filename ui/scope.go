@@ -0,0 +1,347 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gioui.org/io/event"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/widget"
+)
+
+// defaultEvictAfter is how many frames widget state survives without being laid out
+const defaultEvictAfter = 60
+
+// defaultEvictIDsAfter is how many frames the state of a widget with an explicit ID survives
+// without being laid out: ten seconds at 60 frames per second
+const defaultEvictIDsAfter = 600
+
+// ScopeOption configures a Scope
+type ScopeOption func(*Scope)
+
+// EvictAfter sets how many frames a widget without an explicit ID can go without being laid out
+// before its state is dropped
+func EvictAfter(frames int) ScopeOption {
+	return func(s *Scope) { s.evictAfter = frames }
+}
+
+// EvictIDsAfter sets how many frames a widget with an explicit ID can go without being laid out
+// before its state is dropped
+func EvictIDsAfter(frames int) ScopeOption {
+	return func(s *Scope) { s.evictIDsAfter = frames }
+}
+
+// DescribeIDs marks Buttons and scroll views that have an explicit ID in the semantic tree,
+// so test drivers such as uitest can find where they are on screen (see ButtonDescription)
+func DescribeIDs() ScopeOption {
+	return func(s *Scope) { s.describeIDs = true }
+}
+
+// Scope stores persistent widget state (clickables, editors, scroll positions) for one widget tree.
+// Every App has its own Scope, so windows never share widget state.
+//
+// Widgets with an explicit ID (ButtonID, TextFieldID, ScrollID) are keyed by that ID;
+// other widgets are keyed by their position in the tree, so two unnamed TextFields
+// get separate editors. State is dropped once its widget has not been laid out for
+// EvictAfter frames, or EvictIDsAfter frames with an explicit ID so lookups by ID keep
+// working for a while after it leaves the screen. A container laid out in between can
+// retain the state below it (ListView items scrolled out of view, hidden tab pages).
+//
+// Dialogs and bottom sheets opened while a scope is laid out are stacked above its widget tree.
+type Scope struct {
+	mu            sync.Mutex
+	entries       map[string]*scopeEntry
+	frame         int
+	evictAfter    int
+	evictIDsAfter int
+	describeIDs   bool
+	nodes         []*scopeNode
+	lastNow       time.Time
+
+	overlays    []*overlay // dialogs and sheets, topmost last
+	nextOverlay int
+	current     *overlay  // overlay being laid out, if any
+	refocus     event.Tag // widget to focus again after an overlay closed
+	invalidate  func()    // requests a redraw of the scope's window
+
+	snackbars     []*snackbar // queued messages, the one on screen first
+	snackbarFrame int         // last frame a snackbar was drawn in
+
+	drawer          *drawerState // drawer of the Scaffold being laid out
+	windowDrawer    *drawerState // drawer of the window's outermost Scaffold, for OpenDrawer between frames
+	windowDrawerSet int          // frame windowDrawer was last laid out in
+}
+
+// scopeEntry is a piece of widget state and the frame it was last used in (internal)
+type scopeEntry struct {
+	value  any
+	frame  int
+	named  bool   // keyed by an explicit ID, so evicted after evictIDsAfter frames
+	parent string // positional key of the container child it was last laid out in
+}
+
+// scopeNode tracks positional keys handed out below one container child (internal)
+type scopeNode struct {
+	prefix string
+	counts map[string]int
+}
+
+// NewScope creates an empty widget state scope
+func NewScope(opts ...ScopeOption) *Scope {
+	s := &Scope{
+		entries:       make(map[string]*scopeEntry),
+		evictAfter:    defaultEvictAfter,
+		evictIDsAfter: defaultEvictIDsAfter,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.begin()
+	return s
+}
+
+// Layout lays out w as one frame of this scope's widget tree
+// Use it when running your own event loop with more than one window
+func (s *Scope) Layout(gtx layout.Context, th *Theme, w Widget) layout.Dimensions {
+	frameMu.Lock()
+	defer frameMu.Unlock()
+	return s.layout(gtx, th, w)
+}
+
+// layout lays out a frame with s as the active scope (caller holds frameMu)
+func (s *Scope) layout(gtx layout.Context, th *Theme, w Widget) layout.Dimensions {
+	activeScope.Store(s)
+	defer activeScope.Store(nil)
+	lastScope.Store(s)
+
+	s.mu.Lock()
+	s.begin()
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.evict()
+		s.mu.Unlock()
+	}()
+
+	if w == nil {
+		return layout.Dimensions{}
+	}
+	updateShaper(th)
+	dims := w(gtx, th)
+	layoutSnackbar(gtx, th, 0) // unless a Scaffold drew it
+	s.layoutOverlays(gtx, th)
+	return dims
+}
+
+// Release drops all state held by the scope
+func (s *Scope) Release() {
+	lastScope.CompareAndSwap(s, nil)
+
+	s.mu.Lock()
+	s.entries = make(map[string]*scopeEntry)
+	overlays := s.overlays
+	s.mu.Unlock()
+
+	for _, o := range overlays {
+		s.closeOverlay(o, nil)
+	}
+}
+
+// begin starts a new frame (caller holds s.mu)
+func (s *Scope) begin() {
+	s.frame++
+	s.nodes = []*scopeNode{{counts: make(map[string]int)}}
+	s.drawer = nil
+}
+
+// evict drops state that has not been used recently (caller holds s.mu)
+func (s *Scope) evict() {
+	for key, e := range s.entries {
+		after := s.evictAfter
+		if e.named {
+			after = s.evictIDsAfter
+		}
+		if s.frame-e.frame >= after {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// position returns the next positional key for a widget of the given kind
+func (s *Scope) position(kind string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	node := s.nodes[len(s.nodes)-1]
+	n := node.counts[kind]
+	node.counts[kind]++
+	return node.prefix + "/" + kind + "#" + strconv.Itoa(n)
+}
+
+// child lays out the index-th child of the container at pos in its own key space
+func (s *Scope) child(pos string, index int, fn func() layout.Dimensions) layout.Dimensions {
+	s.mu.Lock()
+	s.nodes = append(s.nodes, &scopeNode{
+		prefix: pos + "/" + strconv.Itoa(index),
+		counts: make(map[string]int),
+	})
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.nodes = s.nodes[:len(s.nodes)-1]
+		s.mu.Unlock()
+	}()
+	return fn()
+}
+
+// retain marks the state of everything below the container at pos as used in this frame,
+// so children the container skips this frame keep their state
+func (s *Scope) retain(pos string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := pos + "/"
+	for key, e := range s.entries {
+		if strings.HasPrefix(key, prefix) || strings.HasPrefix(e.parent+"/", prefix) {
+			e.frame = s.frame
+		}
+	}
+}
+
+// get returns the value stored under key, if any
+func (s *Scope) get(key string) (any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// getOrCreate returns the value stored under key, creating it if needed
+// State created with named set is evicted after evictIDsAfter frames instead of evictAfter.
+func (s *Scope) getOrCreate(key string, named bool, create func() any) any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		e = &scopeEntry{value: create(), named: named}
+		s.entries[key] = e
+	}
+	if s.current != nil && s.current.first == nil {
+		switch e.value.(type) {
+		case *widget.Clickable, *widget.Editor:
+			s.current.first = e.value
+		}
+	}
+	e.frame = s.frame
+	e.parent = s.nodes[len(s.nodes)-1].prefix
+	return e.value
+}
+
+// activeScope is the scope whose frame is currently being laid out, if any
+var activeScope atomic.Pointer[Scope]
+
+// lastScope is the scope of the most recently laid out frame, if any
+var lastScope atomic.Pointer[Scope]
+
+// targetScope returns the scope that dialogs and snackbars opened outside a Scope method go to:
+// the one being laid out, else the most recently laid out one, if any
+func targetScope() *Scope {
+	if s := activeScope.Load(); s != nil {
+		return s
+	}
+	return lastScope.Load()
+}
+
+// defaultScope holds widget state for widget trees laid out outside App and Scope.Layout;
+// a new frame starts whenever gtx.Now changes
+var defaultScope = NewScope()
+
+// currentScope returns the scope widgets laid out with gtx store their state in
+func currentScope(gtx layout.Context) *Scope {
+	if s := activeScope.Load(); s != nil {
+		return s
+	}
+
+	s := defaultScope
+	s.mu.Lock()
+	if !gtx.Now.Equal(s.lastNow) {
+		s.lastNow = gtx.Now
+		s.evict()
+		s.begin()
+	}
+	s.mu.Unlock()
+	return s
+}
+
+// scopedState returns the state of a widget of the given kind, creating it if needed
+// Widgets with an explicit id share state across the scope; others are keyed by position
+func scopedState[T any](gtx layout.Context, kind, id string, create func() T) T {
+	s := currentScope(gtx)
+	key := kind + ":" + id
+	if id == "" {
+		key = s.position(kind)
+	}
+	return s.getOrCreate(key, id != "", func() any { return create() }).(T)
+}
+
+// lookupState finds the state of the widget with an explicit id in the scope being laid out,
+// else the most recently laid out one, else the default scope
+func lookupState[T any](kind, id string) (T, bool) {
+	s := targetScope()
+	if s == nil {
+		s = defaultScope
+	}
+	return scopeLookup[T](s, kind, id)
+}
+
+// scopedContainer returns the positional key of a multi-child container
+// Lay out each child with scopedChild so each gets its own key space
+func scopedContainer(gtx layout.Context, kind string) string {
+	return currentScope(gtx).position(kind)
+}
+
+// scopedChild lays out the index-th child of the container at pos
+func scopedChild(gtx layout.Context, pos string, index int, fn func() layout.Dimensions) layout.Dimensions {
+	return currentScope(gtx).child(pos, index, fn)
+}
+
+// scopedRetain keeps the state of every child of the container at pos alive for another frame,
+// including children it does not lay out in this one
+func scopedRetain(gtx layout.Context, pos string) {
+	currentScope(gtx).retain(pos)
+}
+
+// scopedRetainChild keeps the state of the index-th child of the container at pos alive for another frame
+func scopedRetainChild(gtx layout.Context, pos string, index int) {
+	currentScope(gtx).retain(pos + "/" + strconv.Itoa(index))
+}
+
+// describe adds a semantic node covering dims that names the widget kind:id, if the current
+// scope was created with DescribeIDs. The node has no input handlers, so it does not take taps.
+func describe(gtx layout.Context, kind, id string, dims layout.Dimensions) {
+	if id == "" || !currentScope(gtx).describeIDs {
+		return
+	}
+	area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+	semantic.DescriptionOp(description(kind, id)).Add(gtx.Ops)
+	area.Pop()
+}
+
+// description is the semantic description of the widget kind:id in a scope created with DescribeIDs
+func description(kind, id string) string {
+	if id == "" {
+		return ""
+	}
+	return "linnui:" + kind + ":" + id
+}
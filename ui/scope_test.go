@@ -0,0 +1,185 @@
+package ui
+
+import (
+	"image"
+	"strconv"
+	"testing"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+)
+
+// layoutFrames lays out n frames of w in s, 16ms apart
+func layoutFrames(s *Scope, w Widget, n int) {
+	th := Light
+	var ops op.Ops
+	for range n {
+		ops.Reset()
+		s.mu.Lock()
+		now := time.Unix(0, 0).Add(time.Duration(s.frame) * 16 * time.Millisecond)
+		s.mu.Unlock()
+		gtx := layout.Context{
+			Ops:         &ops,
+			Now:         now,
+			Constraints: layout.Exact(image.Pt(400, 300)),
+		}
+		s.Layout(gtx, &th, w)
+	}
+}
+
+func TestScopePositionalKeys(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+
+	var first, second *widget.Editor
+	probe := func(dst **widget.Editor) Widget {
+		return func(gtx layout.Context, th *Theme) layout.Dimensions {
+			*dst = getEditor(gtx, "")
+			return layout.Dimensions{}
+		}
+	}
+	w := Column([]any{probe(&first), probe(&second)})
+
+	layoutFrames(s, w, 1)
+	a, b := first, second
+	if a == b {
+		t.Fatal("unnamed widgets at different positions share state")
+	}
+	layoutFrames(s, w, 1)
+	if first != a || second != b {
+		t.Error("positional state changed between frames")
+	}
+}
+
+func TestScopeWindowsDoNotShareState(t *testing.T) {
+	s1, s2 := NewScope(), NewScope()
+	defer s1.Release()
+	defer s2.Release()
+
+	w := Button("OK", ButtonID("ok"))
+	layoutFrames(s1, w, 1)
+	layoutFrames(s2, w, 1)
+
+	c1, ok1 := s1.LookupButton(ButtonID("ok"))
+	c2, ok2 := s2.LookupButton(ButtonID("ok"))
+	if !ok1 || !ok2 {
+		t.Fatalf("lookup found = %v, %v, want true, true", ok1, ok2)
+	}
+	if c1 == c2 {
+		t.Error("two scopes share the state of the same ButtonID")
+	}
+	// Outside a frame the package-level lookup picks the last window laid out
+	for range 10 {
+		if c, _ := LookupButton(ButtonID("ok")); c != c2 {
+			t.Fatal("LookupButton did not return the last laid out scope's clickable")
+		}
+	}
+	layoutFrames(s1, w, 1)
+	if c, _ := LookupButton(ButtonID("ok")); c != c1 {
+		t.Error("LookupButton did not follow the last laid out scope")
+	}
+}
+
+func TestScopeEviction(t *testing.T) {
+	s := NewScope(EvictAfter(5))
+	defer s.Release()
+
+	var positional *widget.Clickable
+	shown := Column([]any{
+		Widget(func(gtx layout.Context, th *Theme) layout.Dimensions {
+			positional = getClickable(gtx, "")
+			return layout.Dimensions{}
+		}),
+		TextField(TextFieldID("name")),
+	})
+	layoutFrames(s, shown, 1)
+	before := positional
+	if before == nil {
+		t.Fatal("positional widget was not laid out")
+	}
+	SetTextFieldValue("name", "kept")
+
+	layoutFrames(s, SizedBox(), 10)
+
+	if e, ok := s.LookupTextField("name"); !ok || e.Text() != "kept" {
+		got := ""
+		if ok {
+			got = e.Text()
+		}
+		t.Errorf("explicit ID state after eviction = %q, want %q", got, "kept")
+	}
+	layoutFrames(s, shown, 1)
+	if positional == before {
+		t.Error("positional state survived EvictAfter frames off screen")
+	}
+}
+
+func TestScopeEvictsExplicitIDs(t *testing.T) {
+	s := NewScope(EvictAfter(5), EvictIDsAfter(20))
+	defer s.Release()
+
+	// One row per ID, like a list whose rows get deleted
+	for i := range 3 {
+		layoutFrames(s, Button("Delete", ButtonID("del_"+strconv.Itoa(i))), 1)
+	}
+	layoutFrames(s, SizedBox(), 10)
+	if _, ok := s.LookupButton(ButtonID("del_0")); !ok {
+		t.Error("explicit ID state dropped before EvictIDsAfter frames")
+	}
+	layoutFrames(s, SizedBox(), 10)
+	if n := len(s.entries); n != 0 {
+		t.Errorf("%d entries left after EvictIDsAfter frames, want 0", n)
+	}
+}
+
+func TestScopeListViewRetainsOffscreenIDs(t *testing.T) {
+	s := NewScope(EvictAfter(5), EvictIDsAfter(5))
+	defer s.Release()
+
+	items := make([]Widget, 100)
+	items[0] = Button("Delete", ButtonID("del_0"))
+	for i := 1; i < len(items); i++ {
+		items[i] = SizedBox(Height(50))
+	}
+	w := ListView(items, ScrollID("list"))
+
+	layoutFrames(s, w, 1)
+	before, _ := s.LookupButton(ButtonID("del_0"))
+	list, _ := s.LookupScrollView(ScrollID("list"))
+	list.Position.First = 50
+	layoutFrames(s, w, 10)
+
+	if after, ok := s.LookupButton(ButtonID("del_0")); !ok || after != before {
+		t.Error("ListView item with an ID lost its state while scrolled out of view")
+	}
+}
+
+func TestScopeListViewRetainsOffscreenItems(t *testing.T) {
+	s := NewScope(EvictAfter(5))
+	defer s.Release()
+
+	var first *widget.Clickable
+	items := make([]Widget, 100)
+	items[0] = func(gtx layout.Context, th *Theme) layout.Dimensions {
+		first = getClickable(gtx, "")
+		return layout.Dimensions{Size: image.Pt(gtx.Constraints.Max.X, 50)}
+	}
+	for i := 1; i < len(items); i++ {
+		items[i] = SizedBox(Height(50))
+	}
+	w := ListView(items, ScrollID("list"))
+
+	layoutFrames(s, w, 1)
+	before := first
+	list, _ := s.LookupScrollView(ScrollID("list"))
+	list.Position.First = 50
+	layoutFrames(s, w, 10)
+
+	list.Position.First = 0
+	layoutFrames(s, w, 1)
+	if first != before {
+		t.Error("ListView item lost its state while scrolled out of view")
+	}
+}
@@ -1,8 +1,6 @@
 package ui
 
 import (
-	"sync"
-
 	"gioui.org/layout"
 	"gioui.org/widget"
 	"gioui.org/widget/material"
@@ -27,7 +25,7 @@ func Direction(d ScrollDirection) ScrollViewOption {
 }
 
 // ScrollID sets a unique ID for the scroll view (for state persistence)
-// Use this when you have multiple scroll views
+// Without an ID the scroll position is keyed by the view's position in the widget tree
 func ScrollID(id string) ScrollViewOption {
 	return func(s *scrollViewModel) { s.id = id }
 }
@@ -39,56 +37,45 @@ type scrollViewModel struct {
 	child     Widget
 }
 
-// scrollRegistry stores list state by ID
-var (
-	scrollRegistry = make(map[string]*widget.List)
-	scrollMu       sync.Mutex
-)
-
-// getList returns a persistent list for the given ID
-func getList(id string) *widget.List {
-	scrollMu.Lock()
-	defer scrollMu.Unlock()
+// getList returns a persistent list for the given ID in the current scope
+func getList(gtx layout.Context, id string) *widget.List {
+	return scopedState(gtx, "scroll", id, func() *widget.List { return new(widget.List) })
+}
 
-	if l, ok := scrollRegistry[id]; ok {
-		return l
+// axis returns the list axis for the configured direction
+func (s *scrollViewModel) axis() layout.Axis {
+	if s.direction == ScrollHorizontal {
+		return layout.Horizontal
 	}
-	l := new(widget.List)
-	scrollRegistry[id] = l
-	return l
+	return layout.Vertical
 }
 
 // ScrollView creates a scrollable container for a single child
 // Usage: ScrollView(child, Direction(ScrollVertical))
 func ScrollView(child Widget, opts ...ScrollViewOption) Widget {
 	s := &scrollViewModel{
-		id:        "default-scroll", // Default ID
-		direction: ScrollVertical,   // sensible default
+		direction: ScrollVertical, // sensible default
 		child:     child,
 	}
 	for _, opt := range opts {
 		opt(s)
 	}
 
-	// Get persistent list state
-	list := getList(s.id)
-
-	// Set the axis based on direction
-	if s.direction == ScrollHorizontal {
-		list.Axis = layout.Horizontal
-	} else {
-		list.Axis = layout.Vertical
-	}
-
 	return func(gtx layout.Context, th *Theme) layout.Dimensions {
 		if s.child == nil {
 			return layout.Dimensions{}
 		}
 
+		// Get persistent list state and set the axis based on direction
+		list := getList(gtx, s.id)
+		list.Axis = s.axis()
+
 		child := s.child
-		return material.List(th.Theme, list).Layout(gtx, 1, func(gtx layout.Context, _ int) layout.Dimensions {
+		dims := material.List(th.Theme, list).Layout(gtx, 1, func(gtx layout.Context, _ int) layout.Dimensions {
 			return child(gtx, th)
 		})
+		describe(gtx, "scroll", s.id, dims)
+		return dims
 	}
 }
 
@@ -97,29 +84,29 @@ func ScrollView(child Widget, opts ...ScrollViewOption) Widget {
 // Usage: ListView([]Widget{item1, item2, ...}, Direction(ScrollVertical))
 func ListView(children []Widget, opts ...ScrollViewOption) Widget {
 	s := &scrollViewModel{
-		id:        "default-listview",
 		direction: ScrollVertical,
 	}
 	for _, opt := range opts {
 		opt(s)
 	}
 
-	// Get persistent list state
-	list := getList(s.id)
-
-	// Set the axis based on direction
-	if s.direction == ScrollHorizontal {
-		list.Axis = layout.Horizontal
-	} else {
-		list.Axis = layout.Vertical
-	}
-
 	return func(gtx layout.Context, th *Theme) layout.Dimensions {
-		return material.List(th.Theme, list).Layout(gtx, len(children), func(gtx layout.Context, i int) layout.Dimensions {
+		// Get persistent list state and set the axis based on direction
+		list := getList(gtx, s.id)
+		list.Axis = s.axis()
+
+		// Items are keyed by index and retained while off screen, so their state survives scrolling
+		pos := scopedContainer(gtx, "listview")
+		scopedRetain(gtx, pos)
+		dims := material.List(th.Theme, list).Layout(gtx, len(children), func(gtx layout.Context, i int) layout.Dimensions {
 			if i < len(children) && children[i] != nil {
-				return children[i](gtx, th)
+				return scopedChild(gtx, pos, i, func() layout.Dimensions {
+					return children[i](gtx, th)
+				})
 			}
 			return layout.Dimensions{}
 		})
+		describe(gtx, "scroll", s.id, dims)
+		return dims
 	}
 }
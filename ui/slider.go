@@ -0,0 +1,354 @@
+package ui
+
+import (
+	"image"
+	"math"
+	"strconv"
+
+	"gioui.org/gesture"
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// SliderOption configures a Slider or RangeSlider
+type SliderOption func(*sliderModel)
+
+// SliderMin sets the smallest selectable value (defaults to 0)
+func SliderMin(v float32) SliderOption {
+	return func(s *sliderModel) { s.min = v }
+}
+
+// SliderMax sets the largest selectable value (defaults to 1)
+func SliderMax(v float32) SliderOption {
+	return func(s *sliderModel) { s.max = v }
+}
+
+// SliderStep snaps values to multiples of step above SliderMin (0 means continuous)
+func SliderStep(step float32) SliderOption {
+	return func(s *sliderModel) { s.step = step }
+}
+
+// OnChangeEnd sets the callback run with the final value once a drag or key press ends
+// The State changes continuously while dragging; use OnChangeEnd for expensive work
+func OnChangeEnd(fn func(value float32)) SliderOption {
+	return func(s *sliderModel) { s.onChangeEnd = fn }
+}
+
+// OnRangeChangeEnd sets the callback run with both values of a RangeSlider once a drag or key press ends
+func OnRangeChangeEnd(fn func(low, high float32)) SliderOption {
+	return func(s *sliderModel) { s.onRangeChangeEnd = fn }
+}
+
+// VerticalSlider lays the slider out bottom (SliderMin) to top (SliderMax)
+func VerticalSlider() SliderOption {
+	return func(s *sliderModel) { s.axis = layout.Vertical }
+}
+
+// ValueLabel shows the value above the thumb while it is dragged or focused
+// A nil format prints the plain number
+// Usage: ValueLabel(func(v float32) string { return fmt.Sprintf("%.0f%%", v) })
+func ValueLabel(format func(value float32) string) SliderOption {
+	return func(s *sliderModel) {
+		if format == nil {
+			format = func(v float32) string { return strconv.FormatFloat(float64(v), 'f', -1, 32) }
+		}
+		s.label = format
+	}
+}
+
+// SliderID sets a unique ID for the slider (for state persistence)
+// Without an ID the slider's state is keyed by its position in the widget tree
+func SliderID(id string) SliderOption {
+	return func(s *sliderModel) { s.id = id }
+}
+
+// sliderModel holds slider configuration (internal)
+type sliderModel struct {
+	id               string
+	min, max, step   float32
+	onChangeEnd      func(float32)
+	onRangeChangeEnd func(low, high float32)
+	axis             layout.Axis
+	label            func(float32) string
+}
+
+// sliderState is the persistent input state of a slider (internal)
+type sliderState struct {
+	drag    gesture.Drag
+	active  int // index of the thumb being dragged or moved with keys
+	focused bool
+}
+
+// getSlider returns persistent slider state for the given ID in the current scope
+func getSlider(gtx layout.Context, id string) *sliderState {
+	return scopedState(gtx, "slider", id, func() *sliderState { return new(sliderState) })
+}
+
+// Slider creates a slider selecting a value between SliderMin and SliderMax
+// Usage: Slider(volume, SliderMin(0), SliderMax(100), SliderStep(1), OnChangeEnd(saveVolume))
+func Slider(value *State[float32], opts ...SliderOption) Widget {
+	s := newSliderModel(opts)
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		return s.layout(gtx, th, []*State[float32]{value})
+	}
+}
+
+// RangeSlider creates a slider with two thumbs selecting a range between SliderMin and SliderMax
+// Usage: RangeSlider(minPrice, maxPrice, SliderMax(500), SliderStep(10), ValueLabel(nil))
+func RangeSlider(low, high *State[float32], opts ...SliderOption) Widget {
+	s := newSliderModel(opts)
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		return s.layout(gtx, th, []*State[float32]{low, high})
+	}
+}
+
+// newSliderModel applies opts to the default slider configuration
+func newSliderModel(opts []SliderOption) *sliderModel {
+	s := &sliderModel{max: 1, axis: layout.Horizontal}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.max < s.min {
+		s.min, s.max = s.max, s.min
+	}
+	return s
+}
+
+// layout handles input and draws the track and one thumb per state
+func (s *sliderModel) layout(gtx layout.Context, th *Theme, values []*State[float32]) layout.Dimensions {
+	st := getSlider(gtx, s.id)
+	st.active = min(st.active, len(values)-1)
+
+	thumb := gtx.Dp(unit.Dp(20))
+	target := gtx.Dp(toggleTarget)
+	cs := s.axis.Convert(gtx.Constraints.Max)
+	length := cs.X
+	if length >= unbounded {
+		length = gtx.Dp(unit.Dp(200))
+	}
+	length = max(length, s.axis.Convert(gtx.Constraints.Min).X, target)
+	size := s.axis.Convert(image.Pt(length, target))
+
+	st.update(gtx, s, values, length, target/2)
+
+	// pos maps a value to its pixel offset along the main axis
+	pos := func(v float32) int {
+		frac := float32(0)
+		if s.max > s.min {
+			frac = (v - s.min) / (s.max - s.min)
+		}
+		p := target/2 + int(frac*float32(length-target)+0.5)
+		if s.axis == layout.Vertical {
+			p = length - p
+		}
+		return p
+	}
+	// rect converts a main/cross axis rectangle to screen space
+	rect := func(m0, m1, c0, c1 int) image.Rectangle {
+		return image.Rectangle{Min: s.axis.Convert(image.Pt(m0, c0)), Max: s.axis.Convert(image.Pt(m1, c1))}.Canon()
+	}
+
+	current := make([]float32, len(values))
+	for i, v := range values {
+		current[i] = s.clamp(v.Get())
+	}
+
+	// Track: inactive across the whole length, active up to the thumb or between the thumbs
+	track := gtx.Dp(unit.Dp(4))
+	c0, c1 := target/2-track/2, target/2+track/2
+	paint.FillShape(gtx.Ops, th.Palette.SecondaryContainer, clip.UniformRRect(rect(pos(s.min), pos(s.max), c0, c1), track/2).Op(gtx.Ops))
+	start := pos(s.min)
+	if len(current) == 2 {
+		start = pos(current[0])
+	}
+	end := pos(current[len(current)-1])
+	paint.FillShape(gtx.Ops, th.Palette.Primary, clip.UniformRRect(rect(start, end, c0, c1), track/2).Op(gtx.Ops))
+
+	// Thumbs, with a halo around the active one while it is in use
+	inUse := st.drag.Dragging() || st.drag.Pressed() || st.focused
+	for i, v := range current {
+		p := pos(v)
+		if inUse && i == st.active {
+			halo := rect(p-target/2, p+target/2, 0, target)
+			paint.FillShape(gtx.Ops, withAlpha(th.Palette.Primary, 0x1F), clip.Ellipse(halo).Op(gtx.Ops))
+		}
+		knob := rect(p-thumb/2, p+thumb/2, target/2-thumb/2, target/2+thumb/2)
+		paint.FillShape(gtx.Ops, th.Palette.Primary, clip.Ellipse(knob).Op(gtx.Ops))
+	}
+
+	if s.label != nil && inUse {
+		s.drawLabel(gtx, th, s.label(current[st.active]), s.axis.Convert(image.Pt(pos(current[st.active]), 0)))
+	}
+
+	// Input area covering the whole slider
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+	pointer.CursorPointer.Add(gtx.Ops)
+	st.drag.Add(gtx.Ops)
+	event.Op(gtx.Ops, st)
+
+	return layout.Dimensions{Size: size}
+}
+
+// drawLabel paints the value indicator bubble next to the thumb at p
+func (s *sliderModel) drawLabel(gtx layout.Context, th *Theme, text string, p image.Point) {
+	l, _ := (&textModel{content: text, style: Caption}).label(th)
+	l.Color = th.Palette.OnPrimary
+
+	gtx.Constraints.Min = image.Point{}
+	macro := op.Record(gtx.Ops)
+	dims := layout.UniformInset(unit.Dp(6)).Layout(gtx, l.Layout)
+	call := macro.Stop()
+
+	// Above a horizontal slider, to the left of a vertical one
+	gap := gtx.Dp(unit.Dp(4))
+	offset := image.Pt(p.X-dims.Size.X/2, -dims.Size.Y-gap)
+	if s.axis == layout.Vertical {
+		offset = image.Pt(-dims.Size.X-gap, p.Y-dims.Size.Y/2)
+	}
+
+	defer op.Offset(offset).Push(gtx.Ops).Pop()
+	bubble := image.Rectangle{Max: dims.Size}
+	paint.FillShape(gtx.Ops, th.Palette.Primary, clip.UniformRRect(bubble, dims.Size.Y/2).Op(gtx.Ops))
+	call.Add(gtx.Ops)
+}
+
+// clamp limits v to the slider's range and snaps it to the step
+func (s *sliderModel) clamp(v float32) float32 {
+	if s.step > 0 {
+		v = s.min + float32(math.Round(float64((v-s.min)/s.step)))*s.step
+	}
+	return min(max(v, s.min), s.max)
+}
+
+// keyStep is how far one arrow key press moves a thumb
+func (s *sliderModel) keyStep() float32 {
+	if s.step > 0 {
+		return s.step
+	}
+	return (s.max - s.min) / 100
+}
+
+// changeEnd reports the final values to the callbacks
+func (s *sliderModel) changeEnd(values []*State[float32]) {
+	if s.onChangeEnd != nil && len(values) == 1 {
+		s.onChangeEnd(values[0].Get())
+	}
+	if s.onRangeChangeEnd != nil && len(values) == 2 {
+		s.onRangeChangeEnd(values[0].Get(), values[1].Get())
+	}
+}
+
+// set moves thumb i to v, keeping a range's thumbs from crossing
+func (s *sliderModel) set(values []*State[float32], i int, v float32) {
+	v = s.clamp(v)
+	if len(values) == 2 {
+		if i == 0 {
+			v = min(v, values[1].Get())
+		} else {
+			v = max(v, values[0].Get())
+		}
+	}
+	values[i].Set(v)
+}
+
+// update applies pointer drags and arrow keys to the values
+func (st *sliderState) update(gtx layout.Context, s *sliderModel, values []*State[float32], length, pad int) {
+	// valueAt converts a pointer position along the main axis to a value
+	valueAt := func(p float32) float32 {
+		if s.axis == layout.Vertical {
+			p = float32(length) - p
+		}
+		frac := (p - float32(pad)) / float32(max(length-2*pad, 1))
+		return s.min + min(max(frac, 0), 1)*(s.max-s.min)
+	}
+
+	for {
+		e, ok := st.drag.Update(gtx.Metric, gtx.Source, gesture.Axis(s.axis))
+		if !ok {
+			break
+		}
+		p := e.Position.X
+		if s.axis == layout.Vertical {
+			p = e.Position.Y
+		}
+		switch e.Kind {
+		case pointer.Press:
+			v := valueAt(p)
+			st.active = 0
+			if len(values) == 2 {
+				// Grab the nearer thumb; past the high thumb always grab it
+				low, high := values[0].Get(), values[1].Get()
+				if math.Abs(float64(v-high)) < math.Abs(float64(v-low)) || v > high {
+					st.active = 1
+				}
+			}
+			gtx.Execute(key.FocusCmd{Tag: st})
+			s.set(values, st.active, v)
+		case pointer.Drag:
+			s.set(values, st.active, valueAt(p))
+		case pointer.Release, pointer.Cancel:
+			s.changeEnd(values)
+		}
+	}
+
+	filters := []event.Filter{
+		key.FocusFilter{Target: st},
+		key.Filter{Focus: st, Name: key.NameLeftArrow},
+		key.Filter{Focus: st, Name: key.NameRightArrow},
+		key.Filter{Focus: st, Name: key.NameUpArrow},
+		key.Filter{Focus: st, Name: key.NameDownArrow},
+		key.Filter{Focus: st, Name: key.NamePageUp},
+		key.Filter{Focus: st, Name: key.NamePageDown},
+		key.Filter{Focus: st, Name: key.NameHome},
+		key.Filter{Focus: st, Name: key.NameEnd},
+	}
+	// Tab moves between the thumbs of a range before leaving the slider
+	if len(values) == 2 && st.active == 0 {
+		filters = append(filters, key.Filter{Focus: st, Name: key.NameTab})
+	}
+	if len(values) == 2 && st.active == 1 {
+		filters = append(filters, key.Filter{Focus: st, Name: key.NameTab, Required: key.ModShift})
+	}
+
+	for {
+		ev, ok := gtx.Event(filters...)
+		if !ok {
+			break
+		}
+		switch ev := ev.(type) {
+		case key.FocusEvent:
+			st.focused = ev.Focus
+		case key.Event:
+			if ev.State != key.Press {
+				break
+			}
+			v := values[st.active].Get()
+			step := s.keyStep()
+			switch ev.Name {
+			case key.NameTab:
+				st.active = 1 - st.active
+				continue
+			case key.NameRightArrow, key.NameUpArrow:
+				v += step
+			case key.NameLeftArrow, key.NameDownArrow:
+				v -= step
+			case key.NamePageUp:
+				v += 10 * step
+			case key.NamePageDown:
+				v -= 10 * step
+			case key.NameHome:
+				v = s.min
+			case key.NameEnd:
+				v = s.max
+			}
+			s.set(values, st.active, v)
+			s.changeEnd(values)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"testing"
+
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+)
+
+func TestSliderClampSnapsToStep(t *testing.T) {
+	s := newSliderModel([]SliderOption{SliderMin(10), SliderMax(50), SliderStep(5)})
+	tests := []struct{ in, want float32 }{
+		{10, 10},
+		{12, 10},
+		{13, 15},
+		{47.4, 45},
+		{-100, 10},
+		{49, 50},
+		{100, 50},
+	}
+	for _, tt := range tests {
+		if got := s.clamp(tt.in); got != tt.want {
+			t.Errorf("clamp(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	// Without a step values are only clamped
+	s = newSliderModel([]SliderOption{SliderMax(1)})
+	if got := s.clamp(0.33); got != 0.33 {
+		t.Errorf("clamp(0.33) without a step = %v, want 0.33", got)
+	}
+}
+
+func TestSliderSetKeepsThumbsFromCrossing(t *testing.T) {
+	s := newSliderModel([]SliderOption{SliderMax(100), SliderStep(1)})
+	low, high := NewState[float32](20), NewState[float32](60)
+	values := []*State[float32]{low, high}
+
+	s.set(values, 0, 80)
+	if low.Get() != 60 {
+		t.Errorf("low thumb moved past the high one to %v, want 60", low.Get())
+	}
+	s.set(values, 1, 10)
+	if high.Get() != 60 {
+		t.Errorf("high thumb moved past the low one to %v, want 60", high.Get())
+	}
+	s.set(values, 1, 90.6)
+	if high.Get() != 91 {
+		t.Errorf("high = %v, want 91", high.Get())
+	}
+}
+
+func TestSliderKeys(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+	var r input.Router
+	var frame int
+
+	value := NewState[float32](50)
+	var ended []float32
+	w := Slider(value, SliderMax(100), SliderStep(2), SliderID("volume"), OnChangeEnd(func(v float32) { ended = append(ended, v) }))
+	routedFrame(s, &r, w, &frame)
+	st, _ := s.get("slider:volume")
+	r.Source().Execute(key.FocusCmd{Tag: st})
+	routedFrame(s, &r, w, &frame)
+
+	tests := []struct {
+		name key.Name
+		want float32
+	}{
+		{key.NameRightArrow, 52},
+		{key.NameUpArrow, 54},
+		{key.NameLeftArrow, 52},
+		{key.NameDownArrow, 50},
+		{key.NamePageUp, 70},
+		{key.NamePageDown, 50},
+		{key.NameEnd, 100},
+		{key.NameRightArrow, 100},
+		{key.NameHome, 0},
+		{key.NameLeftArrow, 0},
+	}
+	for _, tt := range tests {
+		r.Queue(key.Event{Name: tt.name, State: key.Press})
+		routedFrame(s, &r, w, &frame)
+		if got := value.Get(); got != tt.want {
+			t.Errorf("after %s value = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if len(ended) != len(tests) {
+		t.Errorf("OnChangeEnd called %d times, want once per key press (%d)", len(ended), len(tests))
+	}
+}
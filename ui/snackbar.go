@@ -0,0 +1,276 @@
+package ui
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/gesture"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+// SnackbarOption configures a snackbar
+type SnackbarOption func(*snackbar)
+
+// Action adds a button to the snackbar that calls fn and dismisses it
+// Usage: ShowSnackbar("Message archived", Action("Undo", restore))
+func Action(label string, fn func()) SnackbarOption {
+	return func(s *snackbar) {
+		s.actionLabel = label
+		s.action = fn
+	}
+}
+
+// Duration sets how long the snackbar stays visible (defaults to 4 seconds)
+// Zero or less keeps it until it's swiped away or its action is tapped
+func Duration(d time.Duration) SnackbarOption {
+	return func(s *snackbar) { s.duration = d }
+}
+
+// defaultSnackbarDuration is how long a snackbar stays without a Duration option
+const defaultSnackbarDuration = 4 * time.Second
+
+// Snackbar animation timings
+const (
+	snackbarEnter = 250 * time.Millisecond
+	snackbarExit  = 150 * time.Millisecond
+)
+
+// snackbar is a queued message and the state of its animation (internal)
+type snackbar struct {
+	message     string
+	actionLabel string
+	action      func()
+	duration    time.Duration
+
+	shown   time.Time // first frame on screen
+	closing time.Time // start of the exit animation, once dismissed
+	button  widget.Clickable
+	drag    gesture.Drag
+	start   float32 // pointer position where the swipe started
+	dragX   float32 // current swipe distance
+}
+
+// ShowSnackbar queues a short message shown at the bottom of the Scaffold, above its FAB.
+// Messages are shown one at a time and dismiss themselves after their Duration or when
+// swiped sideways. Call it from an event handler or any goroutine once the window has been
+// laid out; outside a Scaffold the message appears at the bottom of the window.
+// It goes to the window being laid out, else to the last one laid out; with several
+// windows, call the method of the window's Scope instead.
+// Usage: ShowSnackbar("Message archived", Action("Undo", restore), Duration(4*time.Second))
+func ShowSnackbar(message string, opts ...SnackbarOption) {
+	targetScope().ShowSnackbar(message, opts...)
+}
+
+// ShowSnackbar queues a message in this scope's window, like the ShowSnackbar function
+// Usage: app.Scope().ShowSnackbar("Upload finished")
+func (s *Scope) ShowSnackbar(message string, opts ...SnackbarOption) {
+	if s == nil {
+		return
+	}
+	sb := &snackbar{message: message, duration: defaultSnackbarDuration}
+	for _, opt := range opts {
+		opt(sb)
+	}
+
+	s.mu.Lock()
+	s.snackbars = append(s.snackbars, sb)
+	s.mu.Unlock()
+	if s.invalidate != nil {
+		s.invalidate()
+	}
+}
+
+// frontSnackbar returns the snackbar on screen, if any
+func (s *Scope) frontSnackbar() *snackbar {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.snackbars) == 0 {
+		return nil
+	}
+	return s.snackbars[0]
+}
+
+// popSnackbar removes sb from the front of the queue
+func (s *Scope) popSnackbar(sb *snackbar) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.snackbars) > 0 && s.snackbars[0] == sb {
+		s.snackbars = s.snackbars[1:]
+	}
+}
+
+// layoutSnackbar draws the current snackbar at the bottom of gtx.Constraints.Max, bottom pixels
+// above the edge. Only the first call in a frame draws it.
+func layoutSnackbar(gtx layout.Context, th *Theme, bottom int) {
+	s := currentScope(gtx)
+	s.mu.Lock()
+	drawn := s.snackbarFrame == s.frame
+	s.snackbarFrame = s.frame
+	s.mu.Unlock()
+	if drawn {
+		return
+	}
+
+	sb := s.frontSnackbar()
+	if sb == nil {
+		return
+	}
+	if sb.shown.IsZero() {
+		sb.shown = gtx.Now
+	}
+
+	margin := gtx.Dp(unit.Dp(16))
+	width := min(gtx.Constraints.Max.X-2*margin, gtx.Dp(unit.Dp(600)))
+	sb.update(gtx, width)
+
+	// Animate in by sliding up, out by fading
+	opacity := float32(1)
+	enter := float32(1)
+	if elapsed := gtx.Now.Sub(sb.shown); elapsed < snackbarEnter {
+		enter = easeOut(float32(elapsed) / float32(snackbarEnter))
+		gtx.Execute(op.InvalidateCmd{})
+	}
+	if !sb.closing.IsZero() {
+		elapsed := gtx.Now.Sub(sb.closing)
+		if elapsed >= snackbarExit {
+			s.popSnackbar(sb)
+			gtx.Execute(op.InvalidateCmd{})
+			return
+		}
+		opacity = 1 - float32(elapsed)/float32(snackbarExit)
+		gtx.Execute(op.InvalidateCmd{})
+	}
+	if width > 0 {
+		opacity *= max(0, 1-abs(sb.dragX)/float32(width))
+	}
+
+	cgtx := gtx
+	cgtx.Constraints = layout.Constraints{
+		Min: image.Pt(width, gtx.Dp(unit.Dp(48))),
+		Max: image.Pt(width, gtx.Constraints.Max.Y),
+	}
+	macro := op.Record(gtx.Ops)
+	dims := sb.layout(cgtx, th)
+	call := macro.Stop()
+
+	y := gtx.Constraints.Max.Y - bottom - margin - dims.Size.Y
+	y += int((1 - enter) * float32(dims.Size.Y+margin+bottom))
+	defer op.Offset(image.Pt((gtx.Constraints.Max.X-width)/2, y)).Push(gtx.Ops).Pop()
+
+	// The swipe area stays put while the content follows the pointer
+	area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+	sb.drag.Add(gtx.Ops)
+	area.Pop()
+
+	defer op.Offset(image.Pt(int(sb.dragX), 0)).Push(gtx.Ops).Pop()
+	defer paint.PushOpacity(gtx.Ops, opacity).Pop()
+	call.Add(gtx.Ops)
+}
+
+// update handles the action button, swipes and the dismiss timer
+func (sb *snackbar) update(gtx layout.Context, width int) {
+	if sb.button.Clicked(gtx) {
+		if sb.action != nil {
+			sb.action()
+		}
+		sb.dismiss(gtx.Now)
+	}
+
+	for {
+		e, ok := sb.drag.Update(gtx.Metric, gtx.Source, gesture.Horizontal)
+		if !ok {
+			break
+		}
+		switch e.Kind {
+		case pointer.Press:
+			sb.start = e.Position.X
+		case pointer.Drag:
+			sb.dragX = e.Position.X - sb.start
+		case pointer.Release, pointer.Cancel:
+			if abs(sb.dragX) > float32(width)/3 {
+				sb.dismiss(gtx.Now)
+			} else {
+				sb.dragX = 0
+			}
+		}
+	}
+
+	if sb.duration <= 0 || !sb.closing.IsZero() || sb.drag.Dragging() {
+		return
+	}
+	if deadline := sb.shown.Add(sb.duration); gtx.Now.Before(deadline) {
+		gtx.Execute(op.InvalidateCmd{At: deadline})
+	} else {
+		sb.dismiss(gtx.Now)
+	}
+}
+
+// dismiss starts the exit animation
+func (sb *snackbar) dismiss(now time.Time) {
+	if sb.closing.IsZero() {
+		sb.closing = now
+	}
+}
+
+// layout draws the message and action on an inverse surface
+func (sb *snackbar) layout(gtx layout.Context, th *Theme) layout.Dimensions {
+	return layout.Background{}.Layout(gtx,
+		func(gtx layout.Context) layout.Dimensions {
+			rect := image.Rectangle{Max: gtx.Constraints.Min}
+			paint.FillShape(gtx.Ops, th.Palette.InverseSurface, clip.UniformRRect(rect, gtx.Dp(unit.Dp(4))).Op(gtx.Ops))
+			return layout.Dimensions{Size: rect.Max}
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return layout.W.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min.Y = 0
+				return sb.layoutContent(gtx, th)
+			})
+		},
+	)
+}
+
+// layoutContent lays out the message next to the action button
+func (sb *snackbar) layoutContent(gtx layout.Context, th *Theme) layout.Dimensions {
+	return layout.Inset{Left: unit.Dp(16), Right: unit.Dp(8)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+				return layout.Inset{Top: unit.Dp(14), Bottom: unit.Dp(14), Right: unit.Dp(8)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					l, _ := (&textModel{content: sb.message, style: BodyText}).label(th)
+					l.Color = th.Palette.InverseOnSurface
+					return l.Layout(gtx)
+				})
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if sb.actionLabel == "" {
+					return layout.Dimensions{}
+				}
+				btn := material.Button(th.Theme, &sb.button, sb.actionLabel)
+				btn.Background = withAlpha(th.Palette.InversePrimary, 0)
+				btn.Color = th.Palette.InversePrimary
+				return btn.Layout(gtx)
+			}),
+		)
+	})
+}
+
+// easeOut maps linear animation progress onto a curve that decelerates towards the end
+func easeOut(t float32) float32 {
+	return 1 - (1-t)*(1-t)
+}
+
+// abs returns the absolute value of v
+func abs(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
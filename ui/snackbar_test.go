@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+// frames returns how many 16ms frames of layoutFrames cover d
+func frames(d time.Duration) int {
+	return int(d / (16 * time.Millisecond))
+}
+
+func TestSnackbarQueueAndTimer(t *testing.T) {
+	s := NewScope()
+	defer s.Release()
+	w := Scaffold(Body(SizedBox()))
+
+	front := func() string {
+		if sb := s.frontSnackbar(); sb != nil {
+			return sb.message
+		}
+		return ""
+	}
+
+	s.ShowSnackbar("first")
+	s.ShowSnackbar("second", Duration(time.Second))
+	s.ShowSnackbar("sticky", Duration(0))
+
+	layoutFrames(s, w, 1)
+	if got := front(); got != "first" {
+		t.Fatalf("snackbar on screen = %q, want %q", got, "first")
+	}
+	// The default duration is 4 seconds, then the exit animation runs
+	layoutFrames(s, w, frames(defaultSnackbarDuration)-5)
+	if got := front(); got != "first" {
+		t.Errorf("snackbar on screen just before its duration = %q, want %q", got, "first")
+	}
+	layoutFrames(s, w, 5+frames(snackbarExit)+2)
+	if got := front(); got != "second" {
+		t.Fatalf("snackbar on screen after the first = %q, want %q", got, "second")
+	}
+	layoutFrames(s, w, frames(time.Second+snackbarExit)+3)
+	if got := front(); got != "sticky" {
+		t.Fatalf("snackbar on screen after the second = %q, want %q", got, "sticky")
+	}
+	// Without a duration it stays until dismissed
+	layoutFrames(s, w, frames(time.Minute))
+	if got := front(); got != "sticky" {
+		t.Errorf("snackbar without a duration = %q after a minute, want %q", got, "sticky")
+	}
+	s.frontSnackbar().dismiss(time.Unix(0, 0).Add(time.Duration(s.frame) * 16 * time.Millisecond))
+	layoutFrames(s, w, frames(snackbarExit)+2)
+	if got := front(); got != "" {
+		t.Errorf("snackbar on screen after the last was dismissed = %q, want none", got)
+	}
+}
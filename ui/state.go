@@ -1,58 +1,244 @@
 package ui
 
 import (
+	"slices"
 	"sync"
 
 	"gioui.org/app"
 )
 
 // State is a reactive value that triggers redraw when changed
+// A State can be bound to several windows and observed by any number of subscribers
 type State[T comparable] struct {
-	value  T
-	mu     sync.RWMutex
-	window *app.Window
+	reactive[T]
 }
 
 // NewState creates a new reactive state
 func NewState[T comparable](initial T) *State[T] {
-	return &State[T]{value: initial}
+	s := &State[T]{}
+	s.value = initial
+	s.equal = func(a, b T) bool { return a == b }
+	return s
 }
 
-// Get the current value (safe for reading)
-func (s *State[T]) Get() T {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return s.value
+// Bind sets up the state for reactivity in this app window
+// Binding is additive: a State bound to several windows redraws all of them
+func (s *State[T]) Bind(w *app.Window) *State[T] {
+	s.bind(w)
+	return s
+}
+
+// StateOf is a reactive value of any type, including slices, maps and structs holding them
+// Without an EqualFunc every Set, Update and Mutate triggers a redraw
+type StateOf[T any] struct {
+	reactive[T]
+}
+
+// StateOfOption configures a StateOf
+type StateOfOption[T any] func(*StateOf[T])
+
+// EqualFunc sets how a StateOf decides whether a new value differs from the old one
+// Usage: NewStateOf(todos, EqualFunc(slices.Equal[[]Todo]))
+func EqualFunc[T any](fn func(a, b T) bool) StateOfOption[T] {
+	return func(s *StateOf[T]) { s.equal = fn }
+}
+
+// NewStateOf creates a new reactive state for a value of any type
+func NewStateOf[T any](initial T, opts ...StateOfOption[T]) *StateOf[T] {
+	s := &StateOf[T]{}
+	s.value = initial
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Bind sets up the state for reactivity in this app window
+// Binding is additive: a StateOf bound to several windows redraws all of them
+func (s *StateOf[T]) Bind(w *app.Window) *StateOf[T] {
+	s.bind(w)
+	return s
 }
 
-// Set a new value and trigger redraw if window is attached
-func (s *State[T]) Set(val T) {
-	s.mu.Lock()
-	changed := s.value != val
-	s.value = val
-	s.mu.Unlock()
+// reactive holds the value, bound windows and subscribers shared by State and StateOf (internal)
+type reactive[T any] struct {
+	value   T
+	equal   func(a, b T) bool // nil means every write notifies
+	mu      sync.RWMutex
+	windows []*app.Window
+	subs    map[int]func(old, new T)
+	nextSub int
+}
 
-	if changed && s.window != nil {
-		s.window.Invalidate()
+// Get the current value (safe for reading)
+// Reading a state while an App builds its frame binds the state to that App's window
+func (r *reactive[T]) Get() T {
+	if w := buildingWindow.Load(); w != nil {
+		r.bind(w)
 	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.value
+}
+
+// Set a new value and trigger redraw of every bound window
+func (r *reactive[T]) Set(val T) {
+	r.Update(func(T) T { return val })
 }
 
 // Update applies a function to the current value and sets the result
-func (s *State[T]) Update(fn func(T) T) {
-	s.mu.Lock()
-	oldVal := s.value
+func (r *reactive[T]) Update(fn func(T) T) {
+	r.mu.Lock()
+	oldVal := r.value
 	newVal := fn(oldVal)
-	changed := oldVal != newVal
-	s.value = newVal
-	s.mu.Unlock()
+	r.value = newVal
+	changed := r.equal == nil || !r.equal(oldVal, newVal)
+	r.mu.Unlock()
 
-	if changed && s.window != nil {
-		s.window.Invalidate()
+	if changed {
+		r.notify(oldVal, newVal)
 	}
 }
 
-// Bind sets up the state for reactivity in this app window
-func (s *State[T]) Bind(w *app.Window) *State[T] {
-	s.window = w
-	return s
+// Mutate edits the value in place and always triggers a redraw
+// Subscribers receive a shallow copy of the value taken before fn ran as the old value
+// Usage: todos.Mutate(func(t *[]Todo) { (*t)[0].Done = true })
+func (r *reactive[T]) Mutate(fn func(*T)) {
+	r.mu.Lock()
+	oldVal := r.value
+	fn(&r.value)
+	newVal := r.value
+	r.mu.Unlock()
+
+	r.notify(oldVal, newVal)
+}
+
+// notify redraws the bound windows and calls the subscribers
+func (r *reactive[T]) notify(oldVal, newVal T) {
+	r.mu.RLock()
+	windows := slices.Clone(r.windows)
+	subs := r.subscribers()
+	r.mu.RUnlock()
+
+	for _, w := range windows {
+		w.Invalidate()
+	}
+	for _, fn := range subs {
+		fn(oldVal, newVal)
+	}
+}
+
+// bind adds w to the windows redrawn on change
+func (r *reactive[T]) bind(w *app.Window) {
+	r.mu.Lock()
+	bound := slices.Contains(r.windows, w)
+	if !bound {
+		r.windows = append(r.windows, w)
+	}
+	r.mu.Unlock()
+
+	if !bound {
+		trackBinding(w, r)
+	}
+}
+
+// Unbind stops redrawing the window when the state changes
+func (r *reactive[T]) Unbind(w *app.Window) {
+	r.mu.Lock()
+	r.windows = slices.DeleteFunc(r.windows, func(bound *app.Window) bool { return bound == w })
+	r.mu.Unlock()
+
+	untrackBinding(w, r)
+}
+
+// Subscribe registers fn to be called with the old and new value after every change
+// fn runs on the goroutine that changed the state; call the returned func to unsubscribe
+func (r *reactive[T]) Subscribe(fn func(old, new T)) (unsubscribe func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.subs == nil {
+		r.subs = make(map[int]func(old, new T))
+	}
+	id := r.nextSub
+	r.nextSub++
+	r.subs[id] = fn
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.subs, id)
+	}
+}
+
+// Watch registers fn to be called after every change (implements Observable)
+func (r *reactive[T]) Watch(fn func()) (unwatch func()) {
+	return r.Subscribe(func(_, _ T) { fn() })
+}
+
+// subscribers returns the subscribers in registration order (caller holds r.mu)
+func (r *reactive[T]) subscribers() []func(old, new T) {
+	if len(r.subs) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(r.subs))
+	for id := range r.subs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	fns := make([]func(old, new T), len(ids))
+	for i, id := range ids {
+		fns[i] = r.subs[id]
+	}
+	return fns
+}
+
+// unbinder is implemented by every reactive value that can be bound to a window
+type unbinder interface {
+	Unbind(w *app.Window)
+}
+
+// bindingRegistry stores the reactive values bound to each window
+var (
+	bindingRegistry = make(map[*app.Window]map[unbinder]struct{})
+	bindingMu       sync.Mutex
+)
+
+// trackBinding records that v is bound to w
+func trackBinding(w *app.Window, v unbinder) {
+	bindingMu.Lock()
+	defer bindingMu.Unlock()
+
+	if bindingRegistry[w] == nil {
+		bindingRegistry[w] = make(map[unbinder]struct{})
+	}
+	bindingRegistry[w][v] = struct{}{}
+}
+
+// untrackBinding forgets that v is bound to w
+func untrackBinding(w *app.Window, v unbinder) {
+	bindingMu.Lock()
+	defer bindingMu.Unlock()
+
+	delete(bindingRegistry[w], v)
+	if len(bindingRegistry[w]) == 0 {
+		delete(bindingRegistry, w)
+	}
+}
+
+// UnbindWindow unbinds every State from the window, typically once it is destroyed
+// App does this automatically; call it yourself when running your own event loop
+func UnbindWindow(w *app.Window) {
+	bindingMu.Lock()
+	values := make([]unbinder, 0, len(bindingRegistry[w]))
+	for v := range bindingRegistry[w] {
+		values = append(values, v)
+	}
+	bindingMu.Unlock()
+
+	for _, v := range values {
+		v.Unbind(w)
+	}
 }
@@ -0,0 +1,209 @@
+package ui
+
+import (
+	"slices"
+	"testing"
+
+	"gioui.org/app"
+)
+
+// boundTo reports whether bindingRegistry records v as bound to w
+func boundTo(w *app.Window, v unbinder) bool {
+	bindingMu.Lock()
+	defer bindingMu.Unlock()
+	_, ok := bindingRegistry[w][v]
+	return ok
+}
+
+func TestStateBindIsAdditive(t *testing.T) {
+	w1, w2 := new(app.Window), new(app.Window)
+	defer UnbindWindow(w1)
+	defer UnbindWindow(w2)
+
+	s := NewState(0)
+	s.Bind(w1).Bind(w2).Bind(w1)
+	if !slices.Equal(s.windows, []*app.Window{w1, w2}) {
+		t.Errorf("bound windows = %v, want both windows once", s.windows)
+	}
+	if !boundTo(w1, &s.reactive) || !boundTo(w2, &s.reactive) {
+		t.Error("binding registry misses a window")
+	}
+
+	s.Unbind(w1)
+	if !slices.Equal(s.windows, []*app.Window{w2}) {
+		t.Errorf("bound windows after Unbind = %v, want only the second", s.windows)
+	}
+	if boundTo(w1, &s.reactive) {
+		t.Error("binding registry still holds the unbound window")
+	}
+	s.Set(1) // must not touch the unbound window
+}
+
+func TestStateSubscribersRunInRegistrationOrder(t *testing.T) {
+	s := NewState(1)
+	var calls []string
+	unsubs := make([]func(), 0, 12)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+		unsubs = append(unsubs, s.Subscribe(func(old, new int) {
+			calls = append(calls, name)
+		}))
+	}
+
+	s.Set(2)
+	if want := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}; !slices.Equal(calls, want) {
+		t.Errorf("subscribers ran as %v, want %v", calls, want)
+	}
+
+	unsubs[1]()
+	unsubs[1]() // unsubscribing twice is harmless
+	calls = nil
+	s.Set(3)
+	if want := []string{"a", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"}; !slices.Equal(calls, want) {
+		t.Errorf("subscribers after unsubscribe ran as %v, want %v", calls, want)
+	}
+}
+
+func TestStateSubscribeReceivesOldAndNew(t *testing.T) {
+	s := NewState("a")
+	var got [][2]string
+	unsubscribe := s.Subscribe(func(old, new string) { got = append(got, [2]string{old, new}) })
+
+	s.Set("b")
+	s.Set("b") // equal values do not notify
+	s.Update(func(v string) string { return v + "c" })
+	unsubscribe()
+	s.Set("d")
+
+	if want := [][2]string{{"a", "b"}, {"b", "bc"}}; !slices.Equal(got, want) {
+		t.Errorf("subscriber saw %v, want %v", got, want)
+	}
+}
+
+func TestUnbindWindowClearsRegistry(t *testing.T) {
+	w := new(app.Window)
+	a, b := NewState(0), NewStateOf([]int{1})
+	a.Bind(w)
+	b.Bind(w)
+
+	UnbindWindow(w)
+	if len(a.windows) != 0 || len(b.windows) != 0 {
+		t.Errorf("windows after UnbindWindow = %v, %v, want none", a.windows, b.windows)
+	}
+	bindingMu.Lock()
+	_, tracked := bindingRegistry[w]
+	bindingMu.Unlock()
+	if tracked {
+		t.Error("bindingRegistry still has an entry for the window")
+	}
+}
+
+func TestComputedCachesAndRecomputesOnChange(t *testing.T) {
+	a, b := NewState(1), NewState(2)
+	runs := 0
+	sum := Computed(func() int {
+		runs++
+		return a.Get() + b.Get()
+	}, a, b)
+
+	for range 3 {
+		if got := sum.Get(); got != 3 {
+			t.Fatalf("Get = %d, want 3", got)
+		}
+	}
+	if runs != 1 {
+		t.Errorf("computed %d times before any change, want 1", runs)
+	}
+
+	a.Set(1) // no change, so no recompute
+	if runs != 1 {
+		t.Errorf("computed %d times after a no-op Set, want 1", runs)
+	}
+	b.Set(5)
+	if got := sum.Get(); got != 6 || runs != 2 {
+		t.Errorf("after a dependency changed: Get = %d after %d runs, want 6 after 2", got, runs)
+	}
+}
+
+func TestComputedNotifiesSubscribers(t *testing.T) {
+	n := NewState(2)
+	even := Computed(func() bool { return n.Get()%2 == 0 }, n)
+	var got [][2]bool
+	even.Subscribe(func(old, new bool) { got = append(got, [2]bool{old, new}) })
+
+	n.Set(4) // still even, so subscribers are not called
+	n.Set(5)
+	if want := [][2]bool{{true, false}}; !slices.Equal(got, want) {
+		t.Errorf("subscriber saw %v, want %v", got, want)
+	}
+}
+
+func TestComputedDispose(t *testing.T) {
+	n := NewState(1)
+	double := Computed(func() int { return n.Get() * 2 }, n)
+	notified := 0
+	double.Watch(func() { notified++ })
+
+	double.Dispose()
+	n.Set(10)
+	if got := double.Get(); got != 2 {
+		t.Errorf("Get after Dispose = %d, want the cached 2", got)
+	}
+	if notified != 0 {
+		t.Errorf("watchers notified %d times after Dispose, want 0", notified)
+	}
+	if subs := len(n.subs); subs != 0 {
+		t.Errorf("dependency still has %d subscribers after Dispose", subs)
+	}
+}
+
+func TestStateOfEqualFuncSuppressesNoOpWrites(t *testing.T) {
+	s := NewStateOf([]int{1, 2}, EqualFunc(slices.Equal[[]int]))
+	notified := 0
+	s.Watch(func() { notified++ })
+
+	s.Set([]int{1, 2})
+	s.Update(func(v []int) []int { return slices.Clone(v) })
+	if notified != 0 {
+		t.Errorf("equal writes notified %d times, want 0", notified)
+	}
+	s.Set([]int{1, 2, 3})
+	if notified != 1 {
+		t.Errorf("a changed write notified %d times, want 1", notified)
+	}
+}
+
+func TestStateOfWithoutEqualFuncAlwaysNotifies(t *testing.T) {
+	s := NewStateOf(map[string]int{"a": 1})
+	notified := 0
+	s.Watch(func() { notified++ })
+
+	s.Set(s.Get())
+	s.Update(func(m map[string]int) map[string]int { return m })
+	if notified != 2 {
+		t.Errorf("writes notified %d times, want 2", notified)
+	}
+}
+
+func TestStateOfMutateAlwaysNotifies(t *testing.T) {
+	type todo struct {
+		title string
+		done  bool
+	}
+	s := NewStateOf([]todo{{title: "a"}}, EqualFunc(slices.Equal[[]todo]))
+	var before, after []todo
+	notified := 0
+	s.Subscribe(func(old, new []todo) { before, after = old, new; notified++ })
+
+	s.Mutate(func(v *[]todo) { *v = append(*v, todo{title: "b"}) })
+	if notified != 1 || len(before) != 1 || len(after) != 2 {
+		t.Errorf("append: notified %d times with %v -> %v, want once with 1 -> 2 items", notified, before, after)
+	}
+
+	s.Mutate(func(v *[]todo) {}) // no change, but Mutate cannot tell
+	if notified != 2 {
+		t.Errorf("no-op Mutate notified %d times in total, want 2", notified)
+	}
+	if got := s.Get(); len(got) != 2 || got[1].title != "b" {
+		t.Errorf("value after Mutate = %v", got)
+	}
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// Switch creates an on/off switch bound to a boolean state
+// Usage: Row([]any{Text("Dark mode"), Spacer(), Switch(darkMode)})
+func Switch(on *State[bool], opts ...ToggleOption) Widget {
+	t := newToggleModel(opts)
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		b := getBool(gtx, "switch", t.id)
+		b.Value = on.Get()
+
+		if t.disabled {
+			gtx = gtx.Disabled()
+		}
+		if b.Update(gtx) {
+			on.Set(b.Value)
+		}
+
+		return b.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return drawSwitch(gtx, th, b.Value, t.disabled)
+		})
+	}
+}
+
+// drawSwitch paints a 52x32dp Material 3 switch
+func drawSwitch(gtx layout.Context, th *Theme, on, disabled bool) layout.Dimensions {
+	size := image.Pt(gtx.Dp(unit.Dp(52)), gtx.Dp(unit.Dp(32)))
+	track := image.Rectangle{Max: size}
+	radius := size.Y / 2
+	border := gtx.Dp(unit.Dp(2))
+
+	p := th.Palette
+	trackColor, thumbColor, outline := p.SurfaceContainerHighest, p.Outline, p.Outline
+	thumb := gtx.Dp(unit.Dp(16))
+	center := radius
+	if on {
+		trackColor, thumbColor, outline = p.Primary, p.OnPrimary, p.Primary
+		thumb = gtx.Dp(unit.Dp(24))
+		center = size.X - radius
+	}
+	if disabled {
+		faded := withAlpha(p.OnSurface, 0x1F) // 12%
+		if on {
+			trackColor, thumbColor, outline = faded, p.Surface, faded
+		} else {
+			trackColor, thumbColor, outline = withAlpha(p.SurfaceContainerHighest, 0x1F), withAlpha(p.OnSurface, disabledAlpha), faded
+		}
+	}
+
+	// Track with its outline, then the thumb on top
+	paint.FillShape(gtx.Ops, outline, clip.UniformRRect(track, radius).Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, trackColor, clip.UniformRRect(track.Inset(border), radius-border).Op(gtx.Ops))
+	knob := image.Rect(center-thumb/2, size.Y/2-thumb/2, center+thumb/2, size.Y/2+thumb/2)
+	paint.FillShape(gtx.Ops, thumbColor, clip.Ellipse(knob).Op(gtx.Ops))
+
+	return layout.Dimensions{Size: size}
+}
@@ -0,0 +1,17 @@
+//go:build darwin && !ios
+
+package ui
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// systemBrightness reads the macOS appearance (AppleInterfaceStyle is only set in dark mode)
+func systemBrightness() Brightness {
+	out, err := exec.Command("defaults", "read", "-g", "AppleInterfaceStyle").Output()
+	if err == nil && strings.Contains(string(out), "Dark") {
+		return BrightnessDark
+	}
+	return BrightnessLight
+}
@@ -0,0 +1,25 @@
+//go:build linux && !android
+
+package ui
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// systemBrightness reads the desktop's color scheme preference (GTK_THEME, then GNOME settings)
+func systemBrightness() Brightness {
+	if theme := os.Getenv("GTK_THEME"); theme != "" {
+		if strings.HasSuffix(strings.ToLower(theme), ":dark") {
+			return BrightnessDark
+		}
+		return BrightnessLight
+	}
+
+	out, err := exec.Command("gsettings", "get", "org.gnome.desktop.interface", "color-scheme").Output()
+	if err == nil && strings.Contains(string(out), "prefer-dark") {
+		return BrightnessDark
+	}
+	return BrightnessLight
+}
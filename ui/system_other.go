@@ -0,0 +1,8 @@
+//go:build !(linux && !android) && !(darwin && !ios) && !windows
+
+package ui
+
+// systemBrightness has no platform detection here; use ThemeProvider.SetSystemBrightness
+func systemBrightness() Brightness {
+	return BrightnessLight
+}
@@ -0,0 +1,20 @@
+package ui
+
+import (
+	"golang.org/x/sys/windows/registry"
+)
+
+// systemBrightness reads the Windows "app mode" personalization setting
+func systemBrightness() Brightness {
+	key, err := registry.OpenKey(registry.CURRENT_USER, `Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`, registry.QUERY_VALUE)
+	if err != nil {
+		return BrightnessLight
+	}
+	defer key.Close()
+
+	light, _, err := key.GetIntegerValue("AppsUseLightTheme")
+	if err == nil && light == 0 {
+		return BrightnessDark
+	}
+	return BrightnessLight
+}
@@ -0,0 +1,347 @@
+package ui
+
+import (
+	"image"
+	"slices"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/gesture"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// Tab is one page of Tabs
+type Tab struct {
+	label   string
+	content Widget
+}
+
+// TabItem creates a tab showing content while its label is selected
+func TabItem(label string, content Widget) Tab {
+	return Tab{label: label, content: content}
+}
+
+// TabsOption configures Tabs, TabBar or TabPages
+type TabsOption func(*tabsModel)
+
+// TabsID sets a unique ID for the tabs (for state persistence)
+// Without an ID the tabs' state is keyed by their position in the widget tree
+func TabsID(id string) TabsOption {
+	return func(t *tabsModel) { t.id = id }
+}
+
+// Swipeable lets the user drag the pages sideways to switch tabs
+func Swipeable() TabsOption {
+	return func(t *tabsModel) { t.swipe = true }
+}
+
+// tabsModel holds tabs configuration (internal)
+type tabsModel struct {
+	id    string
+	swipe bool
+	tabs  []Tab
+}
+
+// newTabsModel applies opts to the configuration of tabs
+func newTabsModel(tabs []Tab, opts []TabsOption) *tabsModel {
+	t := &tabsModel{tabs: tabs}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Tab animation timings
+const (
+	tabIndicatorDuration = 250 * time.Millisecond
+	tabPageDuration      = 250 * time.Millisecond
+)
+
+// Tabs creates a tab bar above the page of the selected tab
+// Usage: Tabs(selected, []Tab{TabItem("Inbox", inbox), TabItem("Sent", sent)}, Swipeable())
+func Tabs(selected *State[int], tabs []Tab, opts ...TabsOption) Widget {
+	bar, pages := TabBar(selected, tabs, opts...), TabPages(selected, tabs, opts...)
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		pos := scopedContainer(gtx, "tabs")
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return scopedChild(gtx, pos, 0, func() layout.Dimensions { return bar(gtx, th) })
+			}),
+			layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+				return scopedChild(gtx, pos, 1, func() layout.Dimensions { return pages(gtx, th) })
+			}),
+		)
+	}
+}
+
+// tabBarState is the persistent state of a TabBar (internal)
+type tabBarState struct {
+	clicks []widget.Clickable
+	scroll gesture.Scroll
+	offset int // scroll position of a scrollable bar
+
+	shown    int    // selected tab the indicator is moving to
+	from, to [2]int // indicator start and target, as x and width
+	current  [2]int // indicator position in the last frame
+	start    time.Time
+}
+
+// TabBar creates only the row of tab labels, with an indicator under the selected one.
+// Tabs share the width when they fit and scroll sideways when they don't.
+// Use it in a Scaffold's Bottom slot with TabPages as the Body.
+// Usage: Scaffold(AppBar(TitleBar("Mail")), Bottom(TabBar(selected, tabs)), Body(TabPages(selected, tabs)))
+func TabBar(selected *State[int], tabs []Tab, opts ...TabsOption) Widget {
+	t := newTabsModel(tabs, opts)
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "tabbar", t.id, func() *tabBarState { return &tabBarState{shown: -1} })
+		if len(st.clicks) < len(t.tabs) {
+			st.clicks = slices.Grow(st.clicks, len(t.tabs)-len(st.clicks))[:len(t.tabs)]
+		}
+		for i := range t.tabs {
+			if st.clicks[i].Clicked(gtx) {
+				selected.Set(i)
+			}
+		}
+		sel := selected.Get()
+
+		// Measure the labels
+		padding := gtx.Dp(unit.Dp(16))
+		height := gtx.Dp(unit.Dp(48))
+		labels := make([]op.CallOp, len(t.tabs))
+		sizes := make([]image.Point, len(t.tabs))
+		natural := 0
+		medium := font.Medium
+		for i, tab := range t.tabs {
+			lgtx := gtx
+			lgtx.Constraints = layout.Constraints{Max: image.Pt(unbounded, height)}
+			macro := op.Record(gtx.Ops)
+			l, _ := (&textModel{content: tab.label, style: BodyText, weight: &medium, maxLines: 1}).label(th)
+			l.Color = th.Palette.OnSurfaceVariant
+			if i == sel {
+				l.Color = th.Palette.Primary
+			}
+			sizes[i] = l.Layout(lgtx).Size
+			labels[i] = macro.Stop()
+			natural += max(sizes[i].X+2*padding, gtx.Dp(unit.Dp(90)))
+		}
+
+		width := gtx.Constraints.Max.X
+		if width >= unbounded {
+			width = natural
+		}
+		scrollable := natural > width && len(t.tabs) > 0
+
+		// Place the tabs: equal widths when they fit, natural widths when scrolling
+		xs := make([]int, len(t.tabs))
+		ws := make([]int, len(t.tabs))
+		x := 0
+		for i := range t.tabs {
+			ws[i] = width / max(len(t.tabs), 1)
+			if scrollable {
+				ws[i] = max(sizes[i].X+2*padding, gtx.Dp(unit.Dp(90)))
+			}
+			xs[i], x = x, x+ws[i]
+		}
+
+		if scrollable {
+			dist := st.scroll.Update(gtx.Metric, gtx.Source, gtx.Now, gesture.Horizontal,
+				pointer.ScrollRange{Min: -st.offset, Max: x - width - st.offset}, pointer.ScrollRange{})
+			st.offset += dist
+			if sel != st.shown && sel >= 0 && sel < len(t.tabs) {
+				// Bring the newly selected tab into view
+				st.offset = min(st.offset, xs[sel])
+				st.offset = max(st.offset, xs[sel]+ws[sel]-width)
+			}
+			st.offset = min(max(st.offset, 0), x-width)
+		} else {
+			st.offset = 0
+		}
+
+		// Animate the indicator towards the selected tab's label
+		if sel >= 0 && sel < len(t.tabs) {
+			target := [2]int{xs[sel] + (ws[sel]-sizes[sel].X)/2, sizes[sel].X}
+			if sel != st.shown {
+				st.from, st.start = st.current, gtx.Now
+				if st.shown == -1 {
+					st.from = target
+				}
+				st.shown = sel
+			}
+			st.to = target
+			progress := float32(1)
+			if elapsed := gtx.Now.Sub(st.start); elapsed < tabIndicatorDuration {
+				progress = easeOut(float32(elapsed) / float32(tabIndicatorDuration))
+				gtx.Execute(op.InvalidateCmd{})
+			}
+			for k := range st.current {
+				st.current[k] = st.from[k] + int(progress*float32(st.to[k]-st.from[k]))
+			}
+		}
+
+		size := image.Pt(width, height)
+		defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+		paint.FillShape(gtx.Ops, th.Palette.Surface, clip.Rect{Max: size}.Op())
+		if scrollable {
+			st.scroll.Add(gtx.Ops)
+		}
+
+		for i := range t.tabs {
+			off := op.Offset(image.Pt(xs[i]-st.offset, 0)).Push(gtx.Ops)
+			tgtx := gtx
+			tgtx.Constraints = layout.Exact(image.Pt(ws[i], height))
+			click := &st.clicks[i]
+			click.Layout(tgtx, func(gtx layout.Context) layout.Dimensions {
+				if click.Hovered() || gtx.Focused(click) {
+					paint.FillShape(gtx.Ops, withAlpha(th.Palette.OnSurface, 0x14), clip.Rect{Max: gtx.Constraints.Min}.Op())
+				}
+				label := op.Offset(gtx.Constraints.Min.Sub(sizes[i]).Div(2)).Push(gtx.Ops)
+				labels[i].Add(gtx.Ops)
+				label.Pop()
+				return layout.Dimensions{Size: gtx.Constraints.Min}
+			})
+			off.Pop()
+		}
+
+		// Divider and indicator along the bottom edge
+		divider := gtx.Dp(unit.Dp(1))
+		paint.FillShape(gtx.Ops, th.Palette.OutlineVariant, clip.Rect{Min: image.Pt(0, height-divider), Max: size}.Op())
+		if sel >= 0 && sel < len(t.tabs) {
+			thickness := gtx.Dp(unit.Dp(3))
+			indicator := image.Rect(st.current[0]-st.offset, height-thickness, st.current[0]-st.offset+st.current[1], height)
+			r := thickness
+			paint.FillShape(gtx.Ops, th.Palette.Primary, clip.RRect{Rect: indicator, NW: r, NE: r}.Op(gtx.Ops))
+		}
+		return layout.Dimensions{Size: size}
+	}
+}
+
+// tabPagesState is the persistent state of TabPages (internal)
+type tabPagesState struct {
+	drag  gesture.Drag
+	start float32 // pointer position where the swipe started
+	dragX int     // current swipe distance
+
+	shown     int       // page on screen
+	prev      int       // page sliding away
+	slideFrom int       // offset of the shown page when its slide started
+	slideAt   time.Time // start of the slide
+}
+
+// TabPages creates only the page of the selected tab, sliding pages in when the selection changes
+// Pages keep their own widget state while other tabs are shown.
+func TabPages(selected *State[int], tabs []Tab, opts ...TabsOption) Widget {
+	t := newTabsModel(tabs, opts)
+
+	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		st := scopedState(gtx, "tabpages", t.id, func() *tabPagesState { return &tabPagesState{shown: -1} })
+		pos := scopedContainer(gtx, "tabpages")
+		// Only the shown pages are laid out; keep the hidden ones' state for when they return
+		scopedRetain(gtx, pos)
+		sel := selected.Get()
+		if sel < 0 || sel >= len(t.tabs) {
+			return layout.Dimensions{Size: gtx.Constraints.Min}
+		}
+		size := gtx.Constraints.Max
+		if size.X >= unbounded {
+			size.X = gtx.Constraints.Min.X
+		}
+
+		if t.swipe {
+			for {
+				e, ok := st.drag.Update(gtx.Metric, gtx.Source, gesture.Horizontal)
+				if !ok {
+					break
+				}
+				switch e.Kind {
+				case pointer.Press:
+					st.start = e.Position.X
+				case pointer.Drag:
+					st.dragX = int(e.Position.X - st.start)
+					// No dragging past the first and last pages
+					if sel == 0 {
+						st.dragX = min(st.dragX, 0)
+					}
+					if sel == len(t.tabs)-1 {
+						st.dragX = max(st.dragX, 0)
+					}
+				case pointer.Release, pointer.Cancel:
+					switch {
+					case st.dragX < -size.X/4 && sel < len(t.tabs)-1:
+						sel++
+						selected.Set(sel)
+					case st.dragX > size.X/4 && sel > 0:
+						sel--
+						selected.Set(sel)
+					default:
+						// Spring back into place
+						st.prev, st.slideFrom, st.slideAt = st.shown, st.dragX, gtx.Now
+					}
+				}
+			}
+		}
+
+		if sel != st.shown {
+			if st.shown >= 0 {
+				// The new page slides in from the side of its tab, continuing any swipe
+				dir := 1
+				if sel < st.shown {
+					dir = -1
+				}
+				st.prev, st.slideFrom, st.slideAt = st.shown, st.dragX+dir*size.X, gtx.Now
+			}
+			st.shown = sel
+		}
+		if !st.drag.Dragging() {
+			st.dragX = 0
+		}
+
+		offset := st.dragX
+		sliding := false
+		if elapsed := gtx.Now.Sub(st.slideAt); !st.drag.Dragging() && elapsed < tabPageDuration {
+			offset = int((1 - easeOut(float32(elapsed)/float32(tabPageDuration))) * float32(st.slideFrom))
+			sliding = true
+			gtx.Execute(op.InvalidateCmd{})
+		}
+
+		defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+		if t.swipe {
+			st.drag.Add(gtx.Ops)
+		}
+
+		pgtx := gtx
+		pgtx.Constraints = layout.Exact(size)
+		page := func(i, x int) {
+			if i < 0 || i >= len(t.tabs) || x <= -size.X || x >= size.X {
+				return
+			}
+			defer op.Offset(image.Pt(x, 0)).Push(gtx.Ops).Pop()
+			scopedChild(gtx, pos, i, func() layout.Dimensions {
+				if t.tabs[i].content == nil {
+					return layout.Dimensions{}
+				}
+				return t.tabs[i].content(pgtx, th)
+			})
+		}
+
+		// The page beside the selected one shows while swiping; while sliding it is the page we left
+		neighbor, x := sel+1, offset+size.X
+		if offset > 0 {
+			neighbor, x = sel-1, offset-size.X
+		}
+		if sliding && st.prev != sel {
+			neighbor = st.prev
+		}
+		if offset != 0 {
+			page(neighbor, x)
+		}
+		page(sel, offset)
+		return layout.Dimensions{Size: size}
+	}
+}
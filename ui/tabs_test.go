@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"testing"
+
+	"gioui.org/layout"
+	"gioui.org/widget"
+)
+
+func TestTabPagesKeepHiddenPageState(t *testing.T) {
+	s := NewScope(EvictAfter(5))
+	defer s.Release()
+
+	var first *widget.Clickable
+	selected := NewState(0)
+	w := TabPages(selected, []Tab{
+		TabItem("First", Widget(func(gtx layout.Context, th *Theme) layout.Dimensions {
+			first = getClickable(gtx, "")
+			return layout.Dimensions{}
+		})),
+		TabItem("Second", SizedBox()),
+	})
+
+	layoutFrames(s, w, 1)
+	before := first
+	if before == nil {
+		t.Fatal("first page was not laid out")
+	}
+	selected.Set(1)
+	layoutFrames(s, w, 40)
+	selected.Set(0)
+	first = nil
+	layoutFrames(s, w, 40)
+	if first != before {
+		t.Error("hidden tab page lost its state")
+	}
+}
@@ -1,7 +1,11 @@
 package ui
 
 import (
+	"image/color"
+
+	"gioui.org/font"
 	"gioui.org/layout"
+	"gioui.org/text"
 	"gioui.org/unit"
 	"gioui.org/widget/material"
 )
@@ -21,24 +25,127 @@ const (
 	Overline
 )
 
+// TextAlignment defines horizontal alignment of text lines
+type TextAlignment int
+
+const (
+	TextStart TextAlignment = iota
+	TextCenter
+	TextEnd
+)
+
+// TextOverflow defines what happens to text cut off by MaxLines
+type TextOverflow int
+
+const (
+	OverflowEllipsis TextOverflow = iota // end the last line with "…"
+	OverflowClip                         // cut the text off without a marker
+)
+
 // TextOption configures the Text widget
-type TextOption func(*textModel)
+type TextOption interface {
+	applyText(t *textModel)
+}
+
+// SpanOption styles characters (size, color, weight, font), so it configures both Text and
+// RichText spans. Options for whole paragraphs (LineHeight, LetterSpacing, TextAlign, MaxLines,
+// Overflow) are only TextOptions, as spans cannot style them.
+type SpanOption interface {
+	TextOption
+	applySpan(t *textModel)
+}
+
+// spanOption is an option for Text and spans (internal)
+type spanOption func(*textModel)
+
+func (o spanOption) applyText(t *textModel) { o(t) }
+func (o spanOption) applySpan(t *textModel) { o(t) }
+
+// lineOption is an option for the lines of a whole Text (internal)
+type lineOption func(*textModel)
+
+func (o lineOption) applyText(t *textModel) { o(t) }
 
 // Size sets a custom font size in sp
-func Size(sp float32) TextOption {
-	return func(t *textModel) { t.size = unit.Sp(sp) }
+func Size(sp float32) SpanOption {
+	return spanOption(func(t *textModel) { t.size = unit.Sp(sp) })
 }
 
 // Style sets a preset text style (H1, H2, Body, etc.)
-func Style(s TextStyle) TextOption {
-	return func(t *textModel) { t.style = s }
+func Style(s TextStyle) SpanOption {
+	return spanOption(func(t *textModel) { t.style = s })
+}
+
+// TextColor sets the text color (defaults to the theme's OnSurface)
+func TextColor(c color.NRGBA) SpanOption {
+	return spanOption(func(t *textModel) { t.color = &c })
+}
+
+// FontWeight sets the font weight, e.g. font.Bold
+func FontWeight(w font.Weight) SpanOption {
+	return spanOption(func(t *textModel) { t.weight = &w })
+}
+
+// Bold draws the text with a bold font weight
+func Bold() SpanOption {
+	return FontWeight(font.Bold)
+}
+
+// Italic draws the text in italics
+func Italic() SpanOption {
+	return spanOption(func(t *textModel) { t.italic = true })
+}
+
+// FontFamily selects a font by family name, e.g. "Inter" or "monospace"
+func FontFamily(family string) SpanOption {
+	return spanOption(func(t *textModel) { t.family = family })
+}
+
+// Code draws the text in a monospace font
+func Code() SpanOption {
+	return FontFamily("monospace")
+}
+
+// LineHeight sets the distance between baselines in sp
+func LineHeight(sp float32) TextOption {
+	return lineOption(func(t *textModel) { t.lineHeight = unit.Sp(sp) })
+}
+
+// LetterSpacing adds extra space between characters in sp (may be negative)
+func LetterSpacing(sp float32) TextOption {
+	return lineOption(func(t *textModel) { t.letterSpacing = unit.Sp(sp); t.hasLetterSpacing = true })
+}
+
+// TextAlign sets the horizontal alignment of the text lines
+func TextAlign(a TextAlignment) TextOption {
+	return lineOption(func(t *textModel) { t.alignment = a })
+}
+
+// MaxLines limits the number of lines shown (0 means no limit)
+func MaxLines(n int) TextOption {
+	return lineOption(func(t *textModel) { t.maxLines = n })
+}
+
+// Overflow sets how text cut off by MaxLines ends (defaults to OverflowEllipsis)
+func Overflow(o TextOverflow) TextOption {
+	return lineOption(func(t *textModel) { t.overflow = o })
 }
 
 // textModel holds text configuration (internal)
 type textModel struct {
-	content string
-	style   TextStyle
-	size    unit.Sp // custom size overrides style
+	content          string
+	style            TextStyle
+	size             unit.Sp      // custom size overrides style
+	color            *color.NRGBA // nil uses the theme color
+	weight           *font.Weight // nil uses the style weight
+	italic           bool
+	family           string
+	lineHeight       unit.Sp
+	letterSpacing    unit.Sp
+	hasLetterSpacing bool
+	alignment        TextAlignment
+	maxLines         int
+	overflow         TextOverflow
 }
 
 // Text creates a text display widget
@@ -49,39 +156,73 @@ func Text(content string, opts ...TextOption) Widget {
 		size:    0, // 0 means use style default
 	}
 	for _, opt := range opts {
-		opt(t)
+		opt.applyText(t)
 	}
 
 	return func(gtx layout.Context, th *Theme) layout.Dimensions {
-		var label material.LabelStyle
-
-		// Apply preset style
-		switch t.style {
-		case H1:
-			label = material.H1(th.Theme, t.content)
-		case H2:
-			label = material.H2(th.Theme, t.content)
-		case H3:
-			label = material.H3(th.Theme, t.content)
-		case H4:
-			label = material.H4(th.Theme, t.content)
-		case H5:
-			label = material.H5(th.Theme, t.content)
-		case H6:
-			label = material.H6(th.Theme, t.content)
-		case Caption:
-			label = material.Caption(th.Theme, t.content)
-		case Overline:
-			label = material.Overline(th.Theme, t.content)
-		default:
-			label = material.Body1(th.Theme, t.content)
+		label, spacing := t.label(th)
+		if spacing != 0 {
+			return layoutSpacedLabel(gtx, label, spacing)
 		}
+		return label.Layout(gtx)
+	}
+}
 
-		// Override with custom size if specified
-		if t.size > 0 {
-			label.TextSize = t.size
-		}
+// label resolves the options against the theme's typography, returning the label and its letter spacing
+func (t *textModel) label(th *Theme) (material.LabelStyle, unit.Sp) {
+	ts := th.Typography.Style(t.style)
 
-		return label.Layout(gtx)
+	// Options override the preset style
+	if t.size > 0 {
+		ts.Size = t.size
+	}
+	if t.weight != nil {
+		ts.Weight = *t.weight
+	}
+	if t.italic {
+		ts.Italic = true
+	}
+	if t.family != "" {
+		ts.Family = t.family
+	}
+	if t.lineHeight > 0 {
+		ts.LineHeight = t.lineHeight
+	}
+	if t.hasLetterSpacing {
+		ts.LetterSpacing = t.letterSpacing
+	}
+
+	label := material.Label(th.Theme, ts.Size, t.content)
+	label.Font.Weight = ts.Weight
+	if ts.Italic {
+		label.Font.Style = font.Italic
+	}
+	if ts.Family != "" {
+		label.Font.Typeface = font.Typeface(ts.Family)
+	}
+	if ts.LineHeight > 0 {
+		label.LineHeight = ts.LineHeight
+		label.LineHeightScale = 1
+	}
+	if t.color != nil {
+		label.Color = *t.color
+	}
+	label.Alignment = t.alignment.gio()
+	label.MaxLines = t.maxLines
+	if t.overflow == OverflowClip {
+		label.Truncator = "\u200b" // zero width, so nothing marks the cut
+	}
+	return label, ts.LetterSpacing
+}
+
+// gio converts the alignment to Gio's text alignment
+func (a TextAlignment) gio() text.Alignment {
+	switch a {
+	case TextCenter:
+		return text.Middle
+	case TextEnd:
+		return text.End
+	default:
+		return text.Start
 	}
 }
@@ -0,0 +1,36 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/markschellhas/linnui/ui"
+	"github.com/markschellhas/linnui/uitest"
+)
+
+func TestLetterSpacedTextStaysWithinMaxWidth(t *testing.T) {
+	white := color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	text := ui.Text("Spaced letters wrap at the width they take up once spaced", ui.LetterSpacing(6), ui.TextColor(ui.Black))
+	w := ui.SizedBox(ui.Width(100), ui.Height(200), text)
+
+	img, err := uitest.Render(w, uitest.Size(200, 200), uitest.Background(white))
+	if err != nil {
+		t.Skipf("no offscreen renderer: %v", err)
+	}
+	inked := 0
+	for y := range 200 {
+		for x := 0; x < 100; x++ {
+			if color.NRGBAModel.Convert(img.At(x, y)) != white {
+				inked++
+			}
+		}
+		for x := 100; x < 200; x++ {
+			if c := color.NRGBAModel.Convert(img.At(x, y)); c != white {
+				t.Fatalf("pixel (%d, %d) = %v beyond the 100px constraint", x, y, c)
+			}
+		}
+	}
+	if inked == 0 {
+		t.Fatal("text was not drawn")
+	}
+}
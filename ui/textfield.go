@@ -1,38 +1,23 @@
 package ui
 
 import (
-	"sync"
-
 	"gioui.org/layout"
 	"gioui.org/unit"
 	"gioui.org/widget"
 	"gioui.org/widget/material"
 )
 
-// editorRegistry stores editor state by ID
-var (
-	editorRegistry = make(map[string]*widget.Editor)
-	editorMu       sync.Mutex
-)
-
-// getEditor returns a persistent editor for the given ID
-func getEditor(id string) *widget.Editor {
-	editorMu.Lock()
-	defer editorMu.Unlock()
-
-	if e, ok := editorRegistry[id]; ok {
-		return e
-	}
-	e := new(widget.Editor)
-	e.SingleLine = true
-	editorRegistry[id] = e
-	return e
+// getEditor returns a persistent editor for the given ID in the current scope
+func getEditor(gtx layout.Context, id string) *widget.Editor {
+	return scopedState(gtx, "textfield", id, func() *widget.Editor {
+		return &widget.Editor{SingleLine: true}
+	})
 }
 
 // TextFieldOption configures the TextField
 type TextFieldOption func(*textFieldModel)
 
-// Hint sets the placeholder text
+// Hint sets the placeholder text of a TextField, Dropdown or Autocomplete
 func Hint(text string) TextFieldOption {
 	return func(t *textFieldModel) { t.hint = text }
 }
@@ -43,6 +28,7 @@ func OnChange(fn func(string)) TextFieldOption {
 }
 
 // TextFieldID sets a unique ID for the text field (for state persistence)
+// Without an ID the field's state is keyed by its position in the widget tree
 func TextFieldID(id string) TextFieldOption {
 	return func(t *textFieldModel) { t.id = id }
 }
@@ -64,19 +50,19 @@ type textFieldModel struct {
 // Usage: TextField(Hint("Enter name"), OnChange(func(s string) { ... }))
 func TextField(opts ...TextFieldOption) Widget {
 	t := &textFieldModel{
-		id:   "textfield_default",
 		hint: "",
 	}
 	for _, opt := range opts {
 		opt(t)
 	}
 
-	// Get persistent editor using the ID
-	editor := getEditor(t.id)
-	editor.SingleLine = !t.multiLine
 	onChange := t.onChange // Capture the handler
 
 	return func(gtx layout.Context, th *Theme) layout.Dimensions {
+		// Get persistent editor using the ID
+		editor := getEditor(gtx, t.id)
+		editor.SingleLine = !t.multiLine
+
 		// Check for text changes
 		for {
 			event, ok := editor.Update(gtx)
@@ -107,11 +93,9 @@ func TextField(opts ...TextFieldOption) Widget {
 }
 
 // TextFieldValue gets the current text value for a TextField by ID
+// Like LookupTextField it searches the window being laid out, else the last one laid out
 func TextFieldValue(id string) string {
-	editorMu.Lock()
-	defer editorMu.Unlock()
-
-	if e, ok := editorRegistry[id]; ok {
+	if e, ok := LookupTextField(id); ok {
 		return e.Text()
 	}
 	return ""
@@ -119,10 +103,7 @@ func TextFieldValue(id string) string {
 
 // SetTextFieldValue sets the text value for a TextField by ID
 func SetTextFieldValue(id string, text string) {
-	editorMu.Lock()
-	defer editorMu.Unlock()
-
-	if e, ok := editorRegistry[id]; ok {
+	if e, ok := LookupTextField(id); ok {
 		e.SetText(text)
 	}
 }
@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"image"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+	"golang.org/x/image/math/fixed"
+)
+
+// spacedLine is a shaped line of a spaced label, its glyphs already spaced apart (internal)
+type spacedLine struct {
+	glyphs                 []text.Glyph
+	width, ascent, descent fixed.Int26_6
+	baseline               int
+}
+
+// maxSpacedReflows bounds how often a spaced label is reshaped to fit its constraints
+const maxSpacedReflows = 8
+
+// layoutSpacedLabel lays out a label with extra space after every glyph cluster.
+// Gio's shaper has no letter spacing, so the shaped glyphs are shifted line by
+// line and aligned here instead of by the shaper. Lines that grow too wide are
+// reflowed at a narrower width until they fit.
+func layoutSpacedLabel(gtx layout.Context, l material.LabelStyle, spacing unit.Sp) layout.Dimensions {
+	cs := gtx.Constraints
+	extra := fixed.I(gtx.Sp(spacing))
+
+	maxWidth := cs.Max.X
+	lines := shapeSpaced(gtx, l, extra, maxWidth)
+	for range maxSpacedReflows {
+		over := widestLine(lines) - cs.Max.X
+		if over <= 0 || maxWidth <= 1 {
+			break
+		}
+		maxWidth = max(maxWidth-over, 1)
+		lines = shapeSpaced(gtx, l, extra, maxWidth)
+	}
+	if len(lines) == 0 {
+		return layout.Dimensions{Size: cs.Min}
+	}
+
+	width := min(max(cs.Min.X, widestLine(lines)), cs.Max.X)
+
+	first, last := lines[0], lines[len(lines)-1]
+	top := first.baseline - first.ascent.Ceil()
+	height := last.baseline + last.descent.Ceil() - top
+
+	for _, line := range lines {
+		if len(line.glyphs) == 0 {
+			continue
+		}
+		var dx int
+		switch l.Alignment {
+		case text.Middle:
+			dx = (width - line.width.Ceil()) / 2
+		case text.End:
+			dx = width - line.width.Ceil()
+		}
+		origin := f32.Pt(float32(dx)+float32(line.glyphs[0].X)/64, float32(line.baseline-top))
+		t := op.Affine(f32.AffineId().Offset(origin)).Push(gtx.Ops)
+		outline := clip.Outline{Path: l.Shaper.Shape(line.glyphs)}.Op().Push(gtx.Ops)
+		paint.ColorOp{Color: l.Color}.Add(gtx.Ops)
+		paint.PaintOp{}.Add(gtx.Ops)
+		outline.Pop()
+		if call := l.Shaper.Bitmaps(line.glyphs); call != (op.CallOp{}) {
+			call.Add(gtx.Ops)
+		}
+		t.Pop()
+	}
+
+	size := cs.Constrain(image.Pt(width, height))
+	return layout.Dimensions{Size: size, Baseline: size.Y - (first.baseline - top)}
+}
+
+// shapeSpaced shapes the label wrapped at maxWidth and spaces its clusters apart by extra
+func shapeSpaced(gtx layout.Context, l material.LabelStyle, extra fixed.Int26_6, maxWidth int) []spacedLine {
+	l.Shaper.LayoutString(text.Parameters{
+		Font:            l.Font,
+		PxPerEm:         fixed.I(gtx.Sp(l.TextSize)),
+		MaxLines:        l.MaxLines,
+		Truncator:       l.Truncator,
+		WrapPolicy:      l.WrapPolicy,
+		MaxWidth:        maxWidth,
+		Locale:          gtx.Locale,
+		LineHeight:      fixed.I(gtx.Sp(l.LineHeight)),
+		LineHeightScale: l.LineHeightScale,
+	}, l.Text)
+
+	var lines []spacedLine
+	var cur spacedLine
+	var shift fixed.Int26_6
+	for g, ok := l.Shaper.NextGlyph(); ok; g, ok = l.Shaper.NextGlyph() {
+		g.X += shift
+		if g.Flags&text.FlagClusterBreak != 0 {
+			shift += extra
+		}
+		if g.Flags&text.FlagParagraphBreak == 0 {
+			cur.glyphs = append(cur.glyphs, g)
+			cur.width = max(cur.width, g.X+g.Advance)
+		}
+		cur.ascent = max(cur.ascent, g.Ascent)
+		cur.descent = max(cur.descent, g.Descent)
+		cur.baseline = int(g.Y)
+		if g.Flags&text.FlagLineBreak != 0 {
+			lines = append(lines, cur)
+			cur = spacedLine{}
+			shift = 0
+		}
+	}
+	if len(cur.glyphs) > 0 {
+		lines = append(lines, cur)
+	}
+	return lines
+}
+
+// widestLine returns the width of the widest line in pixels
+func widestLine(lines []spacedLine) int {
+	width := 0
+	for _, line := range lines {
+		width = max(width, line.width.Ceil())
+	}
+	return width
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"image/color"
+	"math"
 
 	"gioui.org/widget/material"
 )
@@ -37,38 +38,172 @@ var (
 	Orange = color.NRGBA{R: 255, G: 152, B: 0, A: 255}
 )
 
-// Palette defines the color scheme
-type Palette struct {
-	Primary        color.NRGBA
-	OnPrimary      color.NRGBA
-	SurfaceVariant color.NRGBA
+// Brightness selects the light or dark variant of a color scheme
+type Brightness int
+
+const (
+	BrightnessLight Brightness = iota
+	BrightnessDark
+)
+
+// ColorScheme defines the Material 3 color roles
+// "On" colors are meant for text and icons drawn on top of the matching role
+type ColorScheme struct {
+	Primary            color.NRGBA
+	OnPrimary          color.NRGBA
+	PrimaryContainer   color.NRGBA
+	OnPrimaryContainer color.NRGBA
+
+	Secondary            color.NRGBA
+	OnSecondary          color.NRGBA
+	SecondaryContainer   color.NRGBA
+	OnSecondaryContainer color.NRGBA
+
+	Tertiary            color.NRGBA
+	OnTertiary          color.NRGBA
+	TertiaryContainer   color.NRGBA
+	OnTertiaryContainer color.NRGBA
+
+	Error            color.NRGBA
+	OnError          color.NRGBA
+	ErrorContainer   color.NRGBA
+	OnErrorContainer color.NRGBA
+
+	Background   color.NRGBA
+	OnBackground color.NRGBA
+
+	Surface                 color.NRGBA
+	OnSurface               color.NRGBA
+	SurfaceVariant          color.NRGBA
+	OnSurfaceVariant        color.NRGBA
+	SurfaceDim              color.NRGBA
+	SurfaceBright           color.NRGBA
+	SurfaceContainerLowest  color.NRGBA
+	SurfaceContainerLow     color.NRGBA
+	SurfaceContainer        color.NRGBA
+	SurfaceContainerHigh    color.NRGBA
+	SurfaceContainerHighest color.NRGBA
+
 	Outline        color.NRGBA
+	OutlineVariant color.NRGBA
+
+	InverseSurface   color.NRGBA
+	InverseOnSurface color.NRGBA
+	InversePrimary   color.NRGBA
+
+	Shadow color.NRGBA
+	Scrim  color.NRGBA
 }
 
+// Palette is the previous name of ColorScheme
+type Palette = ColorScheme
+
 // Theme holds styling information
 type Theme struct {
 	*material.Theme
-	Palette Palette
+	Palette    ColorScheme
+	Typography Typography
 }
 
+// seedIndigo is the seed color of the built-in themes
+var seedIndigo = color.NRGBA{R: 99, G: 91, B: 255, A: 255}
+
 // Light theme with modern colors
-var Light = Theme{
-	Theme: material.NewTheme(),
-	Palette: Palette{
-		Primary:        color.NRGBA{R: 99, G: 91, B: 255, A: 255}, // Indigo
-		OnPrimary:      color.NRGBA{R: 255, G: 255, B: 255, A: 255},
-		SurfaceVariant: color.NRGBA{R: 240, G: 240, B: 255, A: 255},
-		Outline:        color.NRGBA{R: 150, G: 150, B: 150, A: 255},
-	},
-}
+var Light = ThemeFromSeed(seedIndigo, BrightnessLight)
 
 // Dark theme
-var Dark = Theme{
-	Theme: material.NewTheme(),
-	Palette: Palette{
-		Primary:        color.NRGBA{R: 187, G: 134, B: 252, A: 255}, // Purple
-		OnPrimary:      color.NRGBA{R: 0, G: 0, B: 0, A: 255},
-		SurfaceVariant: color.NRGBA{R: 30, G: 30, B: 46, A: 255},
-		Outline:        color.NRGBA{R: 100, G: 100, B: 100, A: 255},
-	},
+var Dark = ThemeFromSeed(seedIndigo, BrightnessDark)
+
+// ThemeFromSeed derives a complete Material 3 theme from a single seed color
+// Usage: th := ThemeFromSeed(Teal, BrightnessDark)
+func ThemeFromSeed(seed color.NRGBA, brightness Brightness) Theme {
+	return NewTheme(SchemeFromSeed(seed, brightness))
+}
+
+// NewTheme creates a theme using the given color scheme
+func NewTheme(scheme ColorScheme) Theme {
+	th := Theme{
+		Theme:      material.NewTheme(),
+		Palette:    scheme,
+		Typography: DefaultTypography(),
+	}
+	th.Theme.Palette = material.Palette{
+		Bg:         scheme.Surface,
+		Fg:         scheme.OnSurface,
+		ContrastBg: scheme.Primary,
+		ContrastFg: scheme.OnPrimary,
+	}
+	th.Theme.Shaper = newThemeShaper()
+	return th
+}
+
+// SchemeFromSeed derives the Material 3 color roles from a seed color.
+// Tonal palettes are generated in the HCT color space like Material Color
+// Utilities' core palette, which produced the Material 3 baseline scheme:
+// primary keeps the seed's chroma (at least 48), secondary and the neutrals
+// share its hue at chroma 16, 4 and 8, and tertiary is rotated by 60 degrees.
+// The seed #6750A4 gives the baseline primary #6750A4.
+func SchemeFromSeed(seed color.NRGBA, brightness Brightness) ColorScheme {
+	cam := cam16FromColor(seed)
+	primary := tonalPalette{hue: cam.hue, chroma: math.Max(48, cam.chroma)}
+	secondary := tonalPalette{hue: cam.hue, chroma: 16}
+	tertiary := tonalPalette{hue: cam.hue + 60, chroma: 24}
+	neutral := tonalPalette{hue: cam.hue, chroma: 4}
+	neutralVariant := tonalPalette{hue: cam.hue, chroma: 8}
+	errorPalette := tonalPalette{hue: 25, chroma: 84}
+
+	// tone picks the light or dark tone of a role
+	tone := func(p tonalPalette, light, dark float64) color.NRGBA {
+		if brightness == BrightnessDark {
+			return p.tone(dark)
+		}
+		return p.tone(light)
+	}
+
+	return ColorScheme{
+		Primary:            tone(primary, 40, 80),
+		OnPrimary:          tone(primary, 100, 20),
+		PrimaryContainer:   tone(primary, 90, 30),
+		OnPrimaryContainer: tone(primary, 10, 90),
+
+		Secondary:            tone(secondary, 40, 80),
+		OnSecondary:          tone(secondary, 100, 20),
+		SecondaryContainer:   tone(secondary, 90, 30),
+		OnSecondaryContainer: tone(secondary, 10, 90),
+
+		Tertiary:            tone(tertiary, 40, 80),
+		OnTertiary:          tone(tertiary, 100, 20),
+		TertiaryContainer:   tone(tertiary, 90, 30),
+		OnTertiaryContainer: tone(tertiary, 10, 90),
+
+		Error:            tone(errorPalette, 40, 80),
+		OnError:          tone(errorPalette, 100, 20),
+		ErrorContainer:   tone(errorPalette, 90, 30),
+		OnErrorContainer: tone(errorPalette, 10, 90),
+
+		Background:   tone(neutral, 98, 6),
+		OnBackground: tone(neutral, 10, 90),
+
+		Surface:                 tone(neutral, 98, 6),
+		OnSurface:               tone(neutral, 10, 90),
+		SurfaceVariant:          tone(neutralVariant, 90, 30),
+		OnSurfaceVariant:        tone(neutralVariant, 30, 80),
+		SurfaceDim:              tone(neutral, 87, 6),
+		SurfaceBright:           tone(neutral, 98, 24),
+		SurfaceContainerLowest:  tone(neutral, 100, 4),
+		SurfaceContainerLow:     tone(neutral, 96, 10),
+		SurfaceContainer:        tone(neutral, 94, 12),
+		SurfaceContainerHigh:    tone(neutral, 92, 17),
+		SurfaceContainerHighest: tone(neutral, 90, 22),
+
+		Outline:        tone(neutralVariant, 50, 60),
+		OutlineVariant: tone(neutralVariant, 80, 30),
+
+		InverseSurface:   tone(neutral, 20, 90),
+		InverseOnSurface: tone(neutral, 95, 20),
+		InversePrimary:   tone(primary, 80, 40),
+
+		Shadow: tone(neutral, 0, 0),
+		Scrim:  tone(neutral, 0, 0),
+	}
 }
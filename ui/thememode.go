@@ -0,0 +1,169 @@
+package ui
+
+import (
+	"sync/atomic"
+
+	"gioui.org/app"
+	"gioui.org/layout"
+)
+
+// ThemeMode selects which of a ThemeProvider's themes is active
+type ThemeMode int
+
+const (
+	LightMode ThemeMode = iota
+	DarkMode
+	SystemMode // follow the operating system's light/dark setting
+)
+
+// ThemeProviderOption configures a ThemeProvider
+type ThemeProviderOption func(*ThemeProvider)
+
+// LightTheme sets the theme used in LightMode (defaults to Light)
+func LightTheme(th *Theme) ThemeProviderOption {
+	return func(p *ThemeProvider) { p.light.value = th }
+}
+
+// DarkTheme sets the theme used in DarkMode (defaults to Dark)
+func DarkTheme(th *Theme) ThemeProviderOption {
+	return func(p *ThemeProvider) { p.dark.value = th }
+}
+
+// ThemeProvider holds an app's light and dark themes and the active ThemeMode.
+// It is backed by State, so switching the mode or swapping a theme redraws
+// every window using the provider.
+// Usage: themes := NewThemeProvider(SystemMode); Run(root, Themes(themes)); themes.Toggle()
+type ThemeProvider struct {
+	light    *State[*Theme]
+	dark     *State[*Theme]
+	mode     *State[ThemeMode]
+	system   *State[Brightness]
+	querying atomic.Bool // a refreshSystemBrightness query is running
+}
+
+// NewThemeProvider creates a theme provider starting in the given mode
+func NewThemeProvider(mode ThemeMode, opts ...ThemeProviderOption) *ThemeProvider {
+	light, dark := Light, Dark
+	p := &ThemeProvider{
+		light:  NewState(&light),
+		dark:   NewState(&dark),
+		mode:   NewState(mode),
+		system: NewState(BrightnessLight),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if mode == SystemMode {
+		// Query synchronously so the first frame already uses the right theme
+		p.system.value = systemBrightness()
+	}
+	return p
+}
+
+// Theme returns the active theme
+func (p *ThemeProvider) Theme() *Theme {
+	if p.Brightness() == BrightnessDark {
+		return p.dark.Get()
+	}
+	return p.light.Get()
+}
+
+// Brightness reports whether the active theme is the light or the dark one
+func (p *ThemeProvider) Brightness() Brightness {
+	switch p.mode.Get() {
+	case DarkMode:
+		return BrightnessDark
+	case SystemMode:
+		return p.system.Get()
+	default:
+		return BrightnessLight
+	}
+}
+
+// Mode returns the current theme mode
+func (p *ThemeProvider) Mode() ThemeMode {
+	return p.mode.Get()
+}
+
+// SetMode switches the theme mode
+// Switching to SystemMode uses the last known system setting until a fresh one arrives.
+func (p *ThemeProvider) SetMode(mode ThemeMode) {
+	if mode == SystemMode {
+		p.refreshSystemBrightness()
+	}
+	p.mode.Set(mode)
+}
+
+// Toggle switches between LightMode and DarkMode, starting from the active theme
+// Usage: Button("Dark mode", OnClick(themes.Toggle))
+func (p *ThemeProvider) Toggle() {
+	if p.Brightness() == BrightnessDark {
+		p.SetMode(LightMode)
+	} else {
+		p.SetMode(DarkMode)
+	}
+}
+
+// SetLightTheme replaces the theme used in LightMode
+func (p *ThemeProvider) SetLightTheme(th *Theme) {
+	p.light.Set(th)
+}
+
+// SetDarkTheme replaces the theme used in DarkMode
+func (p *ThemeProvider) SetDarkTheme(th *Theme) {
+	p.dark.Set(th)
+}
+
+// SetSystemBrightness records the operating system's light/dark setting used in SystemMode
+// App detects it on start and whenever its window regains focus
+func (p *ThemeProvider) SetSystemBrightness(b Brightness) {
+	p.system.Set(b)
+}
+
+// refreshSystemBrightness queries the operating system's light/dark setting in the background,
+// since that may run a command, and redraws the windows using the provider if it changed
+func (p *ThemeProvider) refreshSystemBrightness() {
+	if p.querying.Swap(true) {
+		return
+	}
+	go func() {
+		defer p.querying.Store(false)
+		p.system.Set(systemBrightness())
+	}()
+}
+
+// Bind sets up the provider for reactivity in this app window
+func (p *ThemeProvider) Bind(w *app.Window) *ThemeProvider {
+	p.light.Bind(w)
+	p.dark.Bind(w)
+	p.mode.Bind(w)
+	p.system.Bind(w)
+	return p
+}
+
+// Watch registers fn to be called whenever the active theme may have changed (implements Observable)
+func (p *ThemeProvider) Watch(fn func()) (unwatch func()) {
+	unwatches := []func(){
+		p.light.Watch(fn),
+		p.dark.Watch(fn),
+		p.mode.Watch(fn),
+		p.system.Watch(fn),
+	}
+	return func() {
+		for _, unwatch := range unwatches {
+			unwatch()
+		}
+	}
+}
+
+// WithTheme lays out child with th instead of the inherited theme
+// Usage: WithTheme(&Dark, Card(...))
+func WithTheme(th *Theme, child Widget) Widget {
+	return func(gtx layout.Context, _ *Theme) layout.Dimensions {
+		if child == nil {
+			return layout.Dimensions{}
+		}
+		updateShaper(th)
+		return child(gtx, th)
+	}
+}
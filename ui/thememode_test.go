@@ -0,0 +1,19 @@
+package ui
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestAppThemeModeSystemAppliesBeforeFirstFrame(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the system setting is only read from GTK_THEME on Linux")
+	}
+	t.Setenv("GTK_THEME", "Adwaita:dark")
+
+	a := NewApp(nil, AppThemeMode(SystemMode))
+	defer a.Scope().Release()
+	if got := a.Themes().Brightness(); got != BrightnessDark {
+		t.Errorf("brightness right after NewApp = %v, want BrightnessDark", got)
+	}
+}
@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// ToggleOption configures a Checkbox, Switch or RadioGroup
+type ToggleOption func(*toggleModel)
+
+// ToggleDisabled greys the control out and ignores input
+func ToggleDisabled() ToggleOption {
+	return func(t *toggleModel) { t.disabled = true }
+}
+
+// ToggleID sets a unique ID for the control (for state persistence)
+// Without an ID the control's state is keyed by its position in the widget tree
+func ToggleID(id string) ToggleOption {
+	return func(t *toggleModel) { t.id = id }
+}
+
+// Indeterminate makes a Checkbox tristate: while indeterminate is true it shows a dash.
+// Tapping cycles unchecked → checked → indeterminate → unchecked.
+// Usage: Checkbox("Select all", allSelected, Indeterminate(someSelected))
+func Indeterminate(indeterminate *State[bool]) ToggleOption {
+	return func(t *toggleModel) { t.indeterminate = indeterminate }
+}
+
+// toggleModel holds the configuration shared by the toggle controls (internal)
+type toggleModel struct {
+	id            string
+	disabled      bool
+	indeterminate *State[bool]
+}
+
+// newToggleModel applies opts to a fresh toggle configuration
+func newToggleModel(opts []ToggleOption) *toggleModel {
+	t := &toggleModel{}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// toggleTarget is the touch target size of checkboxes and radio buttons
+const toggleTarget = unit.Dp(40)
+
+// disabledAlpha is the Material 3 opacity of disabled content (38%)
+const disabledAlpha = 0x61
+
+// withAlpha returns c with its alpha replaced
+func withAlpha(c color.NRGBA, a uint8) color.NRGBA {
+	c.A = a
+	return c
+}
+
+// layoutToggleTarget lays out a control centered in a square touch target,
+// drawing a hover highlight behind it
+func layoutToggleTarget(gtx layout.Context, th *Theme, hovered bool, control layout.Widget) layout.Dimensions {
+	size := gtx.Dp(toggleTarget)
+	gtx.Constraints = layout.Exact(image.Pt(size, size))
+	if hovered {
+		paint.FillShape(gtx.Ops, withAlpha(th.Palette.OnSurface, 0x14), clip.Ellipse{Max: image.Pt(size, size)}.Op(gtx.Ops))
+	}
+	return layout.Center.Layout(gtx, control)
+}
+
+// layoutToggleLabel lays out the text next to a checkbox or radio button
+func layoutToggleLabel(gtx layout.Context, th *Theme, label string, disabled bool) layout.Dimensions {
+	if label == "" {
+		return layout.Dimensions{}
+	}
+	l, _ := (&textModel{content: label, style: BodyText}).label(th)
+	if disabled {
+		l.Color = withAlpha(l.Color, disabledAlpha)
+	}
+	return layout.Inset{Right: unit.Dp(8)}.Layout(gtx, l.Layout)
+}
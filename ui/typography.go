@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"gioui.org/font"
+	"gioui.org/unit"
+)
+
+// TypeStyle describes the font, size and spacing of one TextStyle
+type TypeStyle struct {
+	Family        string // empty uses the theme's default font
+	Size          unit.Sp
+	Weight        font.Weight
+	Italic        bool
+	LineHeight    unit.Sp // 0 uses the font's natural line height
+	LetterSpacing unit.Sp
+}
+
+// Typography is the type scale used by Text for each TextStyle
+// Change it on a Theme to restyle every heading or caption at once.
+// Usage: th.Typography.H1.Weight = font.Bold
+type Typography struct {
+	Body     TypeStyle
+	H1       TypeStyle
+	H2       TypeStyle
+	H3       TypeStyle
+	H4       TypeStyle
+	H5       TypeStyle
+	H6       TypeStyle
+	Caption  TypeStyle
+	Overline TypeStyle
+}
+
+// DefaultTypography returns the material type scale used by the built-in themes
+func DefaultTypography() Typography {
+	return Typography{
+		Body:     TypeStyle{Size: 16},
+		H1:       TypeStyle{Size: 96, Weight: font.Light},
+		H2:       TypeStyle{Size: 60, Weight: font.Light},
+		H3:       TypeStyle{Size: 48},
+		H4:       TypeStyle{Size: 34},
+		H5:       TypeStyle{Size: 24},
+		H6:       TypeStyle{Size: 20, Weight: font.Medium},
+		Caption:  TypeStyle{Size: 12},
+		Overline: TypeStyle{Size: 10},
+	}
+}
+
+// Style returns the type style for a preset TextStyle
+func (t *Typography) Style(s TextStyle) TypeStyle {
+	switch s {
+	case H1:
+		return t.H1
+	case H2:
+		return t.H2
+	case H3:
+		return t.H3
+	case H4:
+		return t.H4
+	case H5:
+		return t.H5
+	case H6:
+		return t.H6
+	case Caption:
+		return t.Caption
+	case Overline:
+		return t.Overline
+	default:
+		return t.Body
+	}
+}
@@ -0,0 +1,155 @@
+package uitest
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/markschellhas/linnui/ui"
+)
+
+// Tolerance sets the largest per-channel difference Golden accepts for a pixel (defaults to 0)
+func Tolerance(delta uint8) Option {
+	return func(t *Tester) { t.tolerance = delta }
+}
+
+// UpdateGolden makes Golden write the golden image instead of comparing against it when update is true
+// Golden also honours a boolean -update flag declared by the test package, so this is only
+// needed when the flag has another name.
+// Usage: uitest.Golden(t, "card", card, size, uitest.UpdateGolden(*regenerate))
+func UpdateGolden(update bool) Option {
+	return func(t *Tester) { t.update = update }
+}
+
+// goldenDir is where golden images are stored, relative to the test's package
+const goldenDir = "testdata"
+
+// Golden renders w at the given size and compares it to testdata/<name>.png
+// Run the test with -update to write the golden image instead of comparing; uitest does not
+// declare the flag itself, so declare it in the test package:
+//
+//	var update = flag.Bool("update", false, "rewrite golden images")
+//
+// On mismatch the rendered image and a diff are written next to the golden image.
+// Usage: uitest.Golden(t, "button_outlined", ui.Button("OK", ui.Variant(ui.Outlined)), image.Pt(200, 80))
+func Golden(t testing.TB, name string, w ui.Widget, size image.Point, opts ...Option) {
+	t.Helper()
+
+	tester := New(func() ui.Widget { return w }, append(opts, Size(size.X, size.Y))...)
+	defer tester.Close()
+
+	tester.Pump()
+	got, err := tester.Screenshot()
+	if err != nil {
+		t.Fatalf("uitest: rendering %s: %v", name, err)
+	}
+
+	path := filepath.Join(goldenDir, name+".png")
+	if tester.update || updating() {
+		if err := writePNG(path, got); err != nil {
+			t.Fatalf("uitest: updating %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := readPNG(path)
+	if err != nil {
+		t.Fatalf("uitest: reading golden image (run with -update to create it): %v", err)
+	}
+
+	diff, mismatched := compareImages(want, got, tester.tolerance)
+	if mismatched == 0 {
+		return
+	}
+
+	actualPath := filepath.Join(goldenDir, name+".actual.png")
+	diffPath := filepath.Join(goldenDir, name+".diff.png")
+	if err := writePNG(actualPath, got); err != nil {
+		t.Errorf("uitest: writing %s: %v", actualPath, err)
+	}
+	if diff != nil {
+		if err := writePNG(diffPath, diff); err != nil {
+			t.Errorf("uitest: writing %s: %v", diffPath, err)
+		}
+	}
+	t.Errorf("uitest: %s differs from golden image in %d pixels (see %s)", name, mismatched, diffPath)
+}
+
+// updating reports whether the test binary has a boolean -update flag that is set
+func updating() bool {
+	f := flag.Lookup("update")
+	if f == nil {
+		return false
+	}
+	getter, ok := f.Value.(flag.Getter)
+	if !ok {
+		return false
+	}
+	update, _ := getter.Get().(bool)
+	return update
+}
+
+// compareImages counts the pixels whose channels differ by more than tolerance
+// and returns an image highlighting them in red over a faded copy of want
+func compareImages(want, got image.Image, tolerance uint8) (*image.NRGBA, int) {
+	if want.Bounds().Size() != got.Bounds().Size() {
+		return nil, want.Bounds().Dx()*want.Bounds().Dy() + got.Bounds().Dx()*got.Bounds().Dy()
+	}
+
+	size := want.Bounds().Size()
+	diff := image.NewNRGBA(image.Rectangle{Max: size})
+	mismatched := 0
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			wc := color.NRGBAModel.Convert(want.At(want.Bounds().Min.X+x, want.Bounds().Min.Y+y)).(color.NRGBA)
+			gc := color.NRGBAModel.Convert(got.At(got.Bounds().Min.X+x, got.Bounds().Min.Y+y)).(color.NRGBA)
+			if channelDelta(wc.R, gc.R) > tolerance || channelDelta(wc.G, gc.G) > tolerance ||
+				channelDelta(wc.B, gc.B) > tolerance || channelDelta(wc.A, gc.A) > tolerance {
+				mismatched++
+				diff.SetNRGBA(x, y, color.NRGBA{R: 255, A: 255})
+				continue
+			}
+			gray := uint8((uint16(wc.R) + uint16(wc.G) + uint16(wc.B)) / 3)
+			diff.SetNRGBA(x, y, color.NRGBA{R: gray, G: gray, B: gray, A: 64})
+		}
+	}
+	return diff, mismatched
+}
+
+// channelDelta returns the absolute difference between two channel values
+func channelDelta(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// readPNG decodes the PNG image at path
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+// writePNG encodes img as a PNG image at path, creating its directory if needed
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
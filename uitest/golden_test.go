@@ -0,0 +1,60 @@
+package uitest
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/markschellhas/linnui/ui"
+)
+
+func TestGoldenDoesNotDeclareUpdateFlag(t *testing.T) {
+	// Test packages declare -update themselves; a second declaration would panic
+	if flag.Lookup("update") != nil {
+		t.Fatal("uitest declares the -update flag")
+	}
+}
+
+func TestGoldenWritesThenCompares(t *testing.T) {
+	if _, err := Render(ui.SizedBox(), Size(4, 4)); err != nil {
+		t.Skipf("no offscreen renderer: %v", err)
+	}
+	chdir(t, t.TempDir())
+
+	box := ui.Container(ui.Background(color.NRGBA{R: 0xff, A: 0xff}), ui.SizedBox(ui.Width(10), ui.Height(10)))
+	Golden(t, "box", box, image.Pt(20, 20), UpdateGolden(true))
+	if _, err := os.Stat(filepath.Join(goldenDir, "box.png")); err != nil {
+		t.Fatalf("golden image not written: %v", err)
+	}
+	Golden(t, "box", box, image.Pt(20, 20))
+}
+
+func TestCompareImagesCountsMismatches(t *testing.T) {
+	want := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	got := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	got.SetNRGBA(0, 0, color.NRGBA{R: 10, A: 0})
+	got.SetNRGBA(1, 1, color.NRGBA{R: 3, A: 0})
+
+	if _, n := compareImages(want, got, 0); n != 2 {
+		t.Errorf("mismatches with tolerance 0 = %d, want 2", n)
+	}
+	if _, n := compareImages(want, got, 5); n != 1 {
+		t.Errorf("mismatches with tolerance 5 = %d, want 1", n)
+	}
+}
+
+// chdir changes the working directory for the rest of the test
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
@@ -0,0 +1,41 @@
+package uitest
+
+import (
+	"fmt"
+	"image"
+
+	"gioui.org/gpu/headless"
+	"gioui.org/op"
+)
+
+// headlessWindow wraps Gio's offscreen renderer (internal)
+type headlessWindow struct {
+	window *headless.Window
+	size   image.Point
+}
+
+// newHeadlessWindow creates an offscreen renderer
+func newHeadlessWindow(size image.Point) (*headlessWindow, error) {
+	w, err := headless.NewWindow(size.X, size.Y)
+	if err != nil {
+		return nil, fmt.Errorf("uitest: no offscreen renderer (see the package doc for machines without a GPU): %w", err)
+	}
+	return &headlessWindow{window: w, size: size}, nil
+}
+
+// render draws the op list and reads the pixels back
+func (h *headlessWindow) render(ops *op.Ops) (*image.RGBA, error) {
+	if err := h.window.Frame(ops); err != nil {
+		return nil, err
+	}
+	img := image.NewRGBA(image.Rectangle{Max: h.size})
+	if err := h.window.Screenshot(img); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// release frees the renderer's resources
+func (h *headlessWindow) release() {
+	h.window.Release()
+}
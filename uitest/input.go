@@ -0,0 +1,115 @@
+package uitest
+
+import (
+	"errors"
+	"image"
+	"unicode/utf8"
+
+	"gioui.org/f32"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+
+	"github.com/markschellhas/linnui/ui"
+)
+
+// ErrNotFound is returned when an input targets a widget that is not on screen
+var ErrNotFound = errors.New("uitest: widget not found")
+
+// Tap presses and releases the pointer at the centre of the Button identified by target,
+// then pumps a frame. The events are hit-tested like a real tap, so a dialog's barrier
+// or another widget drawn over the button takes them instead.
+// Usage: tester.Tap(ui.ButtonID("increment"))
+func (t *Tester) Tap(target ui.ButtonOption) error {
+	t.ensureFrame()
+
+	bounds, ok := t.locate(ui.ButtonDescription(target))
+	if !ok {
+		return ErrNotFound
+	}
+	e := pointer.Event{
+		Source:   pointer.Mouse,
+		Buttons:  pointer.ButtonPrimary,
+		Position: centre(bounds),
+		Time:     t.now.Sub(epoch),
+	}
+	e.Kind = pointer.Press
+	t.router.Queue(e)
+	e.Kind = pointer.Release
+	t.router.Queue(e)
+
+	t.Pump()
+	return nil
+}
+
+// EnterText focuses the TextField with the given ID, replaces its text as if typed, then pumps a frame
+// Usage: tester.EnterText("name_input", "Mark")
+func (t *Tester) EnterText(id string, text string) error {
+	t.ensureFrame()
+
+	editor, ok := t.scope.LookupTextField(id)
+	if !ok {
+		return ErrNotFound
+	}
+	t.router.Source().Execute(key.FocusCmd{Tag: editor})
+	t.router.Queue(key.EditEvent{
+		Range: key.Range{Start: 0, End: utf8.RuneCountInString(editor.Text())},
+		Text:  text,
+	})
+
+	t.Pump()
+	return nil
+}
+
+// Scroll sends a scroll of distance pixels at the centre of the ScrollView or ListView
+// identified by target, like a mouse wheel, then pumps a frame.
+// Positive distances scroll towards the end of the content.
+// Usage: tester.Scroll(ui.ScrollID("feed"), 300)
+func (t *Tester) Scroll(target ui.ScrollViewOption, distance int) error {
+	t.ensureFrame()
+
+	bounds, ok := t.locate(ui.ScrollViewDescription(target))
+	list, found := t.scope.LookupScrollView(target)
+	if !ok || !found {
+		return ErrNotFound
+	}
+	scroll := f32.Pt(0, float32(distance))
+	if list.Axis == layout.Horizontal {
+		scroll = f32.Pt(float32(distance), 0)
+	}
+	t.router.Queue(pointer.Event{
+		Kind:     pointer.Scroll,
+		Source:   pointer.Mouse,
+		Position: centre(bounds),
+		Scroll:   scroll,
+		Time:     t.now.Sub(epoch),
+	})
+
+	t.Pump()
+	return nil
+}
+
+// locate returns where the widget with the semantic description desc was drawn in the last frame
+func (t *Tester) locate(desc string) (image.Rectangle, bool) {
+	if desc == "" {
+		return image.Rectangle{}, false
+	}
+	for _, n := range t.router.AppendSemantics(nil) {
+		if n.Desc.Description == desc {
+			return n.Desc.Bounds, true
+		}
+	}
+	return image.Rectangle{}, false
+}
+
+// centre returns the middle of r
+func centre(r image.Rectangle) f32.Point {
+	return f32.Pt(float32(r.Min.X+r.Max.X)/2, float32(r.Min.Y+r.Max.Y)/2)
+}
+
+// ensureFrame lays out a first frame so widgets exist before input is injected
+func (t *Tester) ensureFrame() {
+	if t.frames == 0 {
+		t.Pump()
+	}
+}
@@ -0,0 +1,184 @@
+// Package uitest lays out and renders LinnUI widgets without opening a window.
+// It is meant for tests and CI machines that have no display or GPU.
+//
+// Rendering needs an offscreen GPU context. On Linux machines without a display or GPU,
+// Mesa's software rasterizer provides one when the tests run with its surfaceless platform:
+//
+//	EGL_PLATFORM=surfaceless LIBGL_ALWAYS_SOFTWARE=1 go test ./...
+//
+// uitest leaves the environment alone, so set these variables in the CI job or shell.
+package uitest
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/io/input"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+
+	"github.com/markschellhas/linnui/ui"
+)
+
+// Option configures a Tester
+type Option func(*Tester)
+
+// Theme sets the theme widgets are laid out with (defaults to ui.Light)
+func Theme(th *ui.Theme) Option {
+	return func(t *Tester) { t.theme = th }
+}
+
+// Size sets the viewport size in pixels (defaults to 800x600)
+func Size(width, height int) Option {
+	return func(t *Tester) { t.size = image.Pt(width, height) }
+}
+
+// Constraints overrides the constraints handed to the root widget
+// By default the root widget is forced to fill the viewport, like in a window
+func Constraints(cs layout.Constraints) Option {
+	return func(t *Tester) { t.constraints = cs; t.hasConstraints = true }
+}
+
+// Scale sets the number of pixels per dp and sp (defaults to 1)
+func Scale(pxPerDp float32) Option {
+	return func(t *Tester) { t.metric = unit.Metric{PxPerDp: pxPerDp, PxPerSp: pxPerDp} }
+}
+
+// Background sets the color painted behind the widget (defaults to the theme's background, like an App)
+func Background(c color.NRGBA) Option {
+	return func(t *Tester) { t.background = c; t.hasBackground = true }
+}
+
+// epoch is the time of a Tester's first frame; each frame advances it by 1/60s
+var epoch = time.Unix(0, 0)
+
+// Tester lays out a widget tree frame by frame into an offscreen op list
+type Tester struct {
+	root           func() ui.Widget
+	theme          *ui.Theme
+	size           image.Point
+	constraints    layout.Constraints
+	hasConstraints bool
+	metric         unit.Metric
+	background     color.NRGBA
+	hasBackground  bool
+	tolerance      uint8
+	update         bool
+
+	ops    op.Ops
+	scope  *ui.Scope
+	router input.Router
+	now    time.Time
+	dims   layout.Dimensions
+	frames int
+	window *headlessWindow
+}
+
+// New creates a Tester whose root widget is rebuilt by root on every frame
+// The Tester keeps its own widget state; call Close when done with it
+// Usage: tester := uitest.New(func() ui.Widget { return screen(state) }, uitest.Size(400, 300))
+func New(root func() ui.Widget, opts ...Option) *Tester {
+	th := ui.Light
+	t := &Tester{
+		root:   root,
+		theme:  &th,
+		size:   image.Pt(800, 600),
+		metric: unit.Metric{PxPerDp: 1, PxPerSp: 1},
+		scope:  ui.NewScope(ui.DescribeIDs()),
+		now:    epoch,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Pump lays out one frame and returns the root widget's dimensions
+func (t *Tester) Pump() layout.Dimensions {
+	t.ops.Reset()
+	t.now = t.now.Add(time.Second / 60)
+	t.frames++
+
+	if t.hasBackground {
+		paint.Fill(&t.ops, t.background)
+	} else {
+		paint.Fill(&t.ops, t.theme.Palette.Background)
+	}
+
+	gtx := layout.Context{
+		Ops:         &t.ops,
+		Now:         t.now,
+		Metric:      t.metric,
+		Constraints: layout.Exact(t.size),
+		Source:      t.router.Source(),
+	}
+	if t.hasConstraints {
+		gtx.Constraints = t.constraints
+	}
+
+	t.dims = layout.Dimensions{}
+	if t.root != nil {
+		t.dims = t.scope.Layout(gtx, t.theme, t.root())
+	}
+
+	t.router.Frame(&t.ops)
+	return t.dims
+}
+
+// Dimensions returns the dimensions reported by the root widget in the last frame
+func (t *Tester) Dimensions() layout.Dimensions {
+	return t.dims
+}
+
+// Ops returns the op list recorded by the last frame
+func (t *Tester) Ops() *op.Ops {
+	return &t.ops
+}
+
+// Screenshot rasterizes the last frame into an image the size of the viewport
+func (t *Tester) Screenshot() (*image.RGBA, error) {
+	if t.window == nil {
+		w, err := newHeadlessWindow(t.size)
+		if err != nil {
+			return nil, err
+		}
+		t.window = w
+	}
+	return t.window.render(&t.ops)
+}
+
+// Scope returns the scope holding the widget state of the tester's widget tree
+func (t *Tester) Scope() *ui.Scope {
+	return t.scope
+}
+
+// Close releases the widget state and the offscreen renderer, if one was created
+func (t *Tester) Close() {
+	t.scope.Release()
+	if t.window != nil {
+		t.window.release()
+		t.window = nil
+	}
+}
+
+// Layout lays out w for a single frame and returns its dimensions
+// Usage: dims := uitest.Layout(ui.Text("Hi"), uitest.Constraints(layout.Constraints{Max: image.Pt(200, 100)}))
+func Layout(w ui.Widget, opts ...Option) layout.Dimensions {
+	t := New(func() ui.Widget { return w }, opts...)
+	defer t.Close()
+
+	return t.Pump()
+}
+
+// Render lays out w for a single frame and rasterizes it
+// Usage: img, err := uitest.Render(ui.Button("OK"), uitest.Size(200, 80))
+func Render(w ui.Widget, opts ...Option) (*image.RGBA, error) {
+	t := New(func() ui.Widget { return w }, opts...)
+	defer t.Close()
+
+	t.Pump()
+	return t.Screenshot()
+}
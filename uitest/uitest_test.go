@@ -0,0 +1,154 @@
+package uitest
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"gioui.org/layout"
+
+	"github.com/markschellhas/linnui/ui"
+)
+
+func TestLayoutReportsDimensions(t *testing.T) {
+	dims := Layout(ui.SizedBox(ui.Width(120), ui.Height(40)), Constraints(layout.Constraints{Max: image.Pt(400, 300)}))
+	if want := image.Pt(120, 40); dims.Size != want {
+		t.Errorf("Layout size = %v, want %v", dims.Size, want)
+	}
+}
+
+func TestLayoutFillsViewportByDefault(t *testing.T) {
+	dims := Layout(ui.Center(ui.Text("Hi")), Size(300, 200))
+	if want := image.Pt(300, 200); dims.Size != want {
+		t.Errorf("Layout size = %v, want %v", dims.Size, want)
+	}
+}
+
+func TestRenderPaintsBackground(t *testing.T) {
+	bg := color.NRGBA{R: 0x20, G: 0x40, B: 0x80, A: 0xff}
+	img, err := Render(ui.SizedBox(), Size(40, 30), Background(bg))
+	if err != nil {
+		t.Skipf("no offscreen renderer: %v", err)
+	}
+	if got := img.Bounds().Size(); got != image.Pt(40, 30) {
+		t.Fatalf("image size = %v, want 40x30", got)
+	}
+	if got := color.NRGBAModel.Convert(img.At(20, 15)).(color.NRGBA); got != bg {
+		t.Errorf("pixel = %v, want %v", got, bg)
+	}
+}
+
+func TestPumpRebuildsFromState(t *testing.T) {
+	width := ui.NewState(10)
+	tester := New(func() ui.Widget {
+		return ui.SizedBox(ui.Width(float32(width.Get())), ui.Height(10))
+	}, Constraints(layout.Constraints{Max: image.Pt(400, 300)}))
+	defer tester.Close()
+
+	if got := tester.Pump().Size.X; got != 10 {
+		t.Fatalf("first frame width = %d, want 10", got)
+	}
+	width.Set(50)
+	if got := tester.Pump().Size.X; got != 50 {
+		t.Errorf("second frame width = %d, want 50", got)
+	}
+	if got := tester.Dimensions().Size.X; got != 50 {
+		t.Errorf("Dimensions width = %d, want 50", got)
+	}
+}
+
+func TestTapClicksButton(t *testing.T) {
+	count := ui.NewState(0)
+	tester := New(func() ui.Widget {
+		return ui.Button("Add", ui.ButtonID("add"), ui.OnClick(func() { count.Set(count.Get() + 1) }))
+	})
+	defer tester.Close()
+
+	for range 2 {
+		if err := tester.Tap(ui.ButtonID("add")); err != nil {
+			t.Fatalf("Tap: %v", err)
+		}
+	}
+	if got := count.Get(); got != 2 {
+		t.Errorf("clicks = %d, want 2", got)
+	}
+	if err := tester.Tap(ui.ButtonID("missing")); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Tap on a missing button = %v, want ErrNotFound", err)
+	}
+}
+
+func TestEnterTextReplacesText(t *testing.T) {
+	var changed string
+	tester := New(func() ui.Widget {
+		return ui.TextField(ui.TextFieldID("name"), ui.OnChange(func(s string) { changed = s }))
+	})
+	defer tester.Close()
+
+	for _, text := range []string{"Ada", "Grace"} {
+		if err := tester.EnterText("name", text); err != nil {
+			t.Fatalf("EnterText: %v", err)
+		}
+		editor, ok := tester.Scope().LookupTextField("name")
+		if !ok {
+			t.Fatal("text field not found after EnterText")
+		}
+		if got := editor.Text(); got != text {
+			t.Errorf("text = %q, want %q", got, text)
+		}
+		if changed != text {
+			t.Errorf("OnChange got %q, want %q", changed, text)
+		}
+	}
+	if err := tester.EnterText("missing", "x"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("EnterText on a missing field = %v, want ErrNotFound", err)
+	}
+}
+
+func TestTapIsBlockedByDialogBarrier(t *testing.T) {
+	count := ui.NewState(0)
+	tester := New(func() ui.Widget {
+		// In the corner, so the tap misses the dialog and lands on the barrier
+		return ui.Column([]any{ui.Button("Add", ui.ButtonID("add"), ui.OnClick(func() { count.Set(count.Get() + 1) }))})
+	})
+	defer tester.Close()
+
+	tester.Pump()
+	closed := tester.Scope().ShowDialog(context.Background(), ui.Text("Busy"))
+	tester.Pump()
+	if err := tester.Tap(ui.ButtonID("add")); err != nil {
+		t.Fatalf("Tap: %v", err)
+	}
+	if got := count.Get(); got != 0 {
+		t.Errorf("clicks under the dialog = %d, want 0", got)
+	}
+	tester.Pump()
+	select {
+	case <-closed:
+	default:
+		t.Error("tapping the barrier did not close the dialog")
+	}
+}
+
+func TestScrollMovesList(t *testing.T) {
+	items := make([]ui.Widget, 50)
+	for i := range items {
+		items[i] = ui.SizedBox(ui.Height(40))
+	}
+	tester := New(func() ui.Widget {
+		return ui.ListView(items, ui.ScrollID("feed"))
+	}, Size(200, 200))
+	defer tester.Close()
+
+	if err := tester.Scroll(ui.ScrollID("feed"), 100); err != nil {
+		t.Fatalf("Scroll: %v", err)
+	}
+	list, _ := tester.Scope().LookupScrollView(ui.ScrollID("feed"))
+	if got := list.Position.First*40 + list.Position.Offset; got != 100 {
+		t.Errorf("scrolled to %d px, want 100", got)
+	}
+	if err := tester.Scroll(ui.ScrollID("missing"), 100); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Scroll on a missing view = %v, want ErrNotFound", err)
+	}
+}